@@ -0,0 +1,101 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+// AbuseSource is the source of the abuse contact email
+type AbuseSource string
+
+const (
+	// AbuseSourceNone means no abuse contact is found
+	AbuseSourceNone AbuseSource = ""
+	// AbuseSourceComment is the "% Abuse contact for '...' is '...'" comment of RIPE and APNIC
+	AbuseSourceComment AbuseSource = "comment"
+	// AbuseSourceOrgAbuse is the OrgAbuseEmail of ARIN
+	AbuseSourceOrgAbuse AbuseSource = "org-abuse"
+	// AbuseSourceAbuseC is the abuse-mailbox of the role or person referenced by abuse-c
+	AbuseSourceAbuseC AbuseSource = "abuse-c"
+	// AbuseSourceIRT is the abuse-mailbox of the irt object referenced by mnt-irt
+	AbuseSourceIRT AbuseSource = "irt"
+	// AbuseSourceEmail is the e-mail of the abuse-c or irt object without abuse-mailbox
+	AbuseSourceEmail AbuseSource = "e-mail"
+)
+
+// AbuseContact returns the abuse contact email of the IP whois information and where it came from,
+// by priority: the abuse comment, the ARIN OrgAbuseEmail, the abuse-mailbox of abuse-c,
+// the abuse-mailbox of irt, and lastly the e-mail of abuse-c or irt, networks are checked in order
+func (i *IPInfo) AbuseContact() (string, AbuseSource) {
+	if i.AbuseComment != "" {
+		return i.AbuseComment, AbuseSourceComment
+	}
+
+	if i.Abuse != nil && i.Abuse.Email != "" {
+		return i.Abuse.Email, AbuseSourceOrgAbuse
+	}
+
+	for _, network := range i.Networks {
+		if network.Abuse != nil && network.Abuse.AbuseMailbox != "" {
+			return network.Abuse.AbuseMailbox, AbuseSourceAbuseC
+		}
+	}
+
+	for _, network := range i.Networks {
+		if network.IRT != nil && network.IRT.AbuseMailbox != "" {
+			return network.IRT.AbuseMailbox, AbuseSourceIRT
+		}
+	}
+
+	for _, network := range i.Networks {
+		for _, contact := range []*Contact{network.Abuse, network.IRT} {
+			if contact != nil && contact.Email != "" {
+				return contact.Email, AbuseSourceEmail
+			}
+		}
+	}
+
+	return "", AbuseSourceNone
+}
+
+// AbuseContact returns the abuse contact email of the AS whois information and where it came from,
+// by priority: the abuse comment, the ARIN OrgAbuseEmail, the abuse-mailbox of abuse-c,
+// and lastly the e-mail of abuse-c
+func (a *ASInfo) AbuseContact() (string, AbuseSource) {
+	if a.AbuseComment != "" {
+		return a.AbuseComment, AbuseSourceComment
+	}
+
+	if a.Abuse == nil {
+		return "", AbuseSourceNone
+	}
+
+	// the abuse contact of ARIN is from the OrgAbuse keys, of the RPSL registries from abuse-c
+	if a.Registry == "arin" && a.Abuse.Email != "" {
+		return a.Abuse.Email, AbuseSourceOrgAbuse
+	}
+
+	if a.Abuse.AbuseMailbox != "" {
+		return a.Abuse.AbuseMailbox, AbuseSourceAbuseC
+	}
+
+	if a.Abuse.Email != "" {
+		return a.Abuse.Email, AbuseSourceEmail
+	}
+
+	return "", AbuseSourceNone
+}
@@ -0,0 +1,112 @@
+package whoisparser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIPAbuseContact tests the priority of the abuse contact sources of IP WHOIS information.
+func TestIPAbuseContact(t *testing.T) {
+	result, err := ParseIPWhois(ripeIPWhois)
+	assert.Nil(t, err)
+	email, source := result.IP.AbuseContact()
+	assert.Equal(t, "abuse@ripe.net", email)
+	assert.Equal(t, AbuseSourceComment, source)
+
+	noComment := strings.ReplaceAll(ripeIPWhois, "% Abuse contact for", "% Contact for")
+	result, err = ParseIPWhois(noComment)
+	assert.Nil(t, err)
+	email, source = result.IP.AbuseContact()
+	assert.Equal(t, "abuse@ripe.net", email)
+	assert.Equal(t, AbuseSourceAbuseC, source)
+
+	noComment = strings.ReplaceAll(apnicIPWhois, "% Abuse contact for", "% Contact for")
+	result, err = ParseIPWhois(noComment)
+	assert.Nil(t, err)
+	assert.Equal(t, "IRT-APNICRANDNET-AU", result.IP.Networks[0].IRT.ID)
+	email, source = result.IP.AbuseContact()
+	assert.Equal(t, "helpdesk@apnic.net", email)
+	assert.Equal(t, AbuseSourceIRT, source)
+
+	result, err = ParseIPWhois(`
+NetRange:       192.0.2.0 - 192.0.2.255
+NetName:        EXAMPLE-NET
+
+OrgAbuseHandle: ABUSE-ARIN
+OrgAbuseName:   Abuse
+OrgAbuseEmail:  abuse@example.com
+`)
+	assert.Nil(t, err)
+	email, source = result.IP.AbuseContact()
+	assert.Equal(t, "abuse@example.com", email)
+	assert.Equal(t, AbuseSourceOrgAbuse, source)
+
+	ipInfo := &IPInfo{Networks: []*Network{{IRT: &Contact{Email: "irt@example.com"}}}}
+	email, source = ipInfo.AbuseContact()
+	assert.Equal(t, "irt@example.com", email)
+	assert.Equal(t, AbuseSourceEmail, source)
+
+	email, source = (&IPInfo{}).AbuseContact()
+	assert.Equal(t, "", email)
+	assert.Equal(t, AbuseSourceNone, source)
+}
+
+// TestASAbuseContact tests the priority of the abuse contact sources of AS WHOIS information.
+func TestASAbuseContact(t *testing.T) {
+	result, err := ParseASWhois(`
+% Abuse contact for 'AS3333' is 'abuse@ripe.net'
+
+aut-num:        AS3333
+as-name:        RIPE-NCC-AS
+abuse-c:        OPS4-RIPE
+source:         RIPE
+
+role:           RIPE NCC Operations
+abuse-mailbox:  noc@ripe.net
+nic-hdl:        OPS4-RIPE
+source:         RIPE
+`)
+	assert.Nil(t, err)
+	email, source := result.AS.AbuseContact()
+	assert.Equal(t, "abuse@ripe.net", email)
+	assert.Equal(t, AbuseSourceComment, source)
+
+	result.AS.AbuseComment = ""
+	email, source = result.AS.AbuseContact()
+	assert.Equal(t, "noc@ripe.net", email)
+	assert.Equal(t, AbuseSourceAbuseC, source)
+
+	result, err = ParseASWhois(`
+ASNumber:       7132
+ASName:         SBIS-AS
+ASHandle:       AS7132
+
+OrgAbuseHandle: ABUSE7-ARIN
+OrgAbuseEmail:  abuse@att.net
+`)
+	assert.Nil(t, err)
+	email, source = result.AS.AbuseContact()
+	assert.Equal(t, "abuse@att.net", email)
+	assert.Equal(t, AbuseSourceOrgAbuse, source)
+
+	// LACNIC aut-num has no source, its abuse-c is not the ARIN OrgAbuse
+	result, err = ParseASWhois(`
+% Joint Whois - whois.lacnic.net
+
+aut-num:     AS28000
+owner:       LACNIC - Latin American and Caribbean IP address
+ownerid:     UY-LACN-LACNIC
+abuse-c:     GIA
+
+nic-hdl:     GIA
+person:      Gerardo Rada
+e-mail:      abuse@lacnic.net
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, "lacnic", result.AS.Registry)
+	email, source = result.AS.AbuseContact()
+	assert.Equal(t, "abuse@lacnic.net", email)
+	assert.Equal(t, AbuseSourceEmail, source)
+}
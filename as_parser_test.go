@@ -1,7 +1,9 @@
 package whoisparser
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/likexian/gokit/assert"
 )
@@ -305,6 +307,21 @@ func TestIsASWhois(t *testing.T) {
 			input:    "ASNumber: 12345\nASName: TEST-AS\nASHandle: AS12345\nOrgName: Test Org",
 			expected: true,
 		},
+		{
+			name:     "RPSL AS WHOIS",
+			input:    "aut-num:     AS28000\nowner:       LACNIC",
+			expected: true,
+		},
+		{
+			name:     "LACNIC IP WHOIS with origin AS",
+			input:    "inetnum:     200.3.12.0/22\nstatus:      assigned\naut-num:     AS28000",
+			expected: false,
+		},
+		{
+			name:     "LACNIC IP WHOIS without origin AS",
+			input:    "inetnum:     200.160.0.0/20\naut-num:     N/A",
+			expected: false,
+		},
 		{
 			name:     "Empty Input",
 			input:    "",
@@ -319,3 +336,410 @@ func TestIsASWhois(t *testing.T) {
 		})
 	}
 }
+
+// TestParseForAS tests that ParseFor detects AS WHOIS responses about other AS numbers.
+func TestParseForAS(t *testing.T) {
+	input := `
+ASNumber:       7132
+ASName:         SBIS-AS
+ASHandle:       AS7132
+`
+	result, err := ParseFor("AS7132", input)
+	assert.Nil(t, err)
+	assert.Equal(t, "SBIS-AS", result.AS.Name)
+
+	_, err = ParseFor("7132", input)
+	assert.Nil(t, err)
+
+	result, err = ParseFor("as15169", input)
+	assert.Equal(t, ErrQueryMismatch, err)
+	assert.Equal(t, "7132", result.AS.Number)
+}
+
+// TestParseASWhoisRange tests that AS number ranges are parsed into typed values and matched by ParseFor.
+func TestParseASWhoisRange(t *testing.T) {
+	input := `
+ASNumber:       7018 - 7019
+ASName:         ATT-INTERNET4
+ASHandle:       AS7018
+`
+	result, err := ParseASWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, result.AS.Number, "7018 - 7019")
+	assert.Equal(t, result.AS.Start, ASN(7018))
+	assert.Equal(t, result.AS.End, ASN(7019))
+
+	_, err = ParseFor("AS7019", input)
+	assert.Nil(t, err)
+
+	_, err = ParseFor("AS7020", input)
+	assert.Equal(t, err, ErrQueryMismatch)
+
+	input = `
+aut-num:        AS1.10
+as-name:        EXAMPLE-AS
+source:         RIPE
+`
+	result, err = ParseASWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, result.AS.Start, ASN(65546))
+	assert.Equal(t, result.AS.End, ASN(65546))
+
+	_, err = ParseFor("65546", input)
+	assert.Nil(t, err)
+
+	_, err = ParseFor("AS1.10", input)
+	assert.Nil(t, err)
+}
+
+// TestParseASWhoisDateTimes tests that AS WHOIS dates are parsed into time values.
+func TestParseASWhoisDateTimes(t *testing.T) {
+	input := `
+ASNumber:       7132
+ASName:         SBIS-AS
+ASHandle:       AS7132
+RegDate:        1996-09-13
+Updated:        2018-07-18
+
+OrgName:        AT&T Corp.
+OrgId:          AC-3280
+RegDate:        2018-03-05
+Updated:        2024-05-28
+`
+	result, err := ParseASWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, "1996-09-13T00:00:00Z", result.AS.RegDateInTime.Format(time.RFC3339))
+	assert.Equal(t, "2018-07-18T00:00:00Z", result.AS.UpdatedInTime.Format(time.RFC3339))
+	assert.Equal(t, "2018-03-05T00:00:00Z", result.AS.Organization.RegistrationDateInTime.Format(time.RFC3339))
+	assert.Equal(t, "2024-05-28T00:00:00Z", result.AS.Organization.UpdatedInTime.Format(time.RFC3339))
+	assert.False(t, result.AS.RegDateApproximate)
+
+	input = `
+ASNumber:       7132
+ASName:         SBIS-AS
+ASHandle:       AS7132
+RegDate:        before Sep-1996
+Updated:        2018-07-18
+
+OrgName:        AT&T Corp.
+OrgId:          AC-3280
+RegDate:        1996-09
+`
+	result, err = ParseASWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, "1996-09-01T00:00:00Z", result.AS.RegDateInTime.Format(time.RFC3339))
+	assert.True(t, result.AS.RegDateApproximate)
+	assert.False(t, result.AS.UpdatedApproximate)
+	assert.True(t, result.AS.Organization.RegistrationDateApproximate)
+
+	// the dates are parsed by the rules of ARIN, which writes numeric dates month first
+	input = `
+ASNumber:       7132
+ASName:         SBIS-AS
+ASHandle:       AS7132
+RegDate:        03/02/2020
+`
+	result, err = ParseASWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, "arin", result.AS.Registry)
+	assert.Equal(t, "2020-03-02T00:00:00Z", result.AS.RegDateInTime.Format(time.RFC3339))
+}
+
+// TestParseASWhoisRPSL tests parsing of RPSL AS WHOIS information with contacts resolved by handle.
+func TestParseASWhoisRPSL(t *testing.T) {
+	input := `% This is the RIPE Database query service.
+% The objects are in RPSL format.
+
+% Information related to 'AS3333'
+
+% Abuse contact for 'AS3333' is 'abuse@ripe.net'
+
+aut-num:        AS3333
+as-name:        RIPE-NCC-AS
+descr:          Reseaux IP Europeens Network Coordination Centre (RIPE NCC)
+org:            ORG-RIEN1-RIPE
+import:         from AS1103 accept ANY
+export:         to AS1103 announce AS3333
+admin-c:        BRD-RIPE
+tech-c:         GL7321-RIPE
+tech-c:         OPS4-RIPE
+status:         ASSIGNED
+mnt-by:         RIPE-NCC-END-MNT
+mnt-by:         RIPE-NCC-MNT
+created:        2002-08-20T09:04:19Z
+last-modified:  2024-03-26T12:26:12Z
+source:         RIPE
+
+organisation:   ORG-RIEN1-RIPE
+org-name:       Reseaux IP Europeens Network Coordination Centre (RIPE NCC)
+country:        NL
+org-type:       RIR
+abuse-c:        ops4-ripe
+source:         RIPE
+
+role:           RIPE NCC Operations
+address:        P.O. Box 10096
+address:        1001 EB Amsterdam
+phone:          +31 20 535 4444
+abuse-mailbox:  abuse@ripe.net
+nic-hdl:        OPS4-RIPE
+created:        2002-09-16T10:35:13Z
+last-modified:  2024-01-12T09:42:55Z
+source:         RIPE
+
+person:         Brian Riddle
+address:        Amsterdam, Netherlands
+phone:          +31 20 535 4444
+nic-hdl:        BRD-RIPE
+source:         RIPE
+`
+	result, err := ParseASWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, "3333", result.AS.Number)
+	assert.Equal(t, "RIPE-NCC-AS", result.AS.Name)
+	assert.Equal(t, "Reseaux IP Europeens Network Coordination Centre (RIPE NCC)", result.AS.Description)
+	assert.Equal(t, []string{"RIPE-NCC-END-MNT", "RIPE-NCC-MNT"}, result.AS.MntBy)
+	assert.Equal(t, "RIPE", result.AS.Source)
+	assert.Equal(t, "2002-08-20T09:04:19Z", result.AS.RegDateInTime.Format(time.RFC3339))
+	assert.Equal(t, "2024-03-26T12:26:12Z", result.AS.UpdatedInTime.Format(time.RFC3339))
+	assert.Equal(t, "ORG-RIEN1-RIPE", result.AS.Organization.ID)
+	assert.Equal(t, "Brian Riddle", result.AS.Administrative.Name)
+	assert.Equal(t, "OPS4-RIPE", result.AS.Technical.ID)
+	assert.Equal(t, "abuse@ripe.net", result.AS.Abuse.AbuseMailbox)
+	assert.Equal(t, "2002-09-16T10:35:13Z", result.AS.Abuse.RegistrationDateInTime.Format(time.RFC3339))
+	assert.Equal(t, []string{"GL7321-RIPE"}, result.AS.UnresolvedHandles)
+
+	result, err = Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, "3333", result.AS.Number)
+}
+
+// TestParseASWhoisRIPEASBlock tests parsing of RIPE AS WHOIS information starting with the as-block of the aut-num.
+func TestParseASWhoisRIPEASBlock(t *testing.T) {
+	input := `% This is the RIPE Database query service.
+% The objects are in RPSL format.
+%
+% The RIPE Database is subject to Terms and Conditions.
+% See https://docs.db.ripe.net/terms-conditions.html
+
+% Note: this output has been filtered.
+%       To receive output for a database update, use the "-B" flag.
+
+% Information related to 'AS3209 - AS3353'
+
+as-block:       AS3209 - AS3353
+descr:          RIPE NCC ASN block
+remarks:        These AS Numbers are assigned to network operators in the RIPE NCC service region.
+mnt-by:         RIPE-NCC-HM-MNT
+created:        2018-11-22T15:27:19Z
+last-modified:  2018-11-22T15:27:19Z
+source:         RIPE
+
+% Information related to 'AS3333'
+
+% Abuse contact for 'AS3333' is 'abuse@ripe.net'
+
+aut-num:        AS3333
+as-name:        RIPE-NCC-AS
+descr:          Reseaux IP Europeens Network Coordination Centre (RIPE NCC)
+org:            ORG-RIEN1-RIPE
+import:         from AS1103 accept ANY
+export:         to AS1103 announce AS3333
+admin-c:        BRD-RIPE
+tech-c:         OPS4-RIPE
+status:         ASSIGNED
+mnt-by:         RIPE-NCC-END-MNT
+mnt-by:         RIPE-NCC-MNT
+created:        2002-08-20T09:04:19Z
+last-modified:  2024-03-26T12:26:12Z
+source:         RIPE
+
+organisation:   ORG-RIEN1-RIPE
+org-name:       Reseaux IP Europeens Network Coordination Centre (RIPE NCC)
+country:        NL
+org-type:       RIR
+abuse-c:        OPS4-RIPE
+mnt-ref:        RIPE-NCC-MNT
+mnt-by:         RIPE-NCC-MNT
+created:        2012-03-09T13:26:53Z
+last-modified:  2024-01-12T09:42:55Z
+source:         RIPE
+
+role:           RIPE NCC Operations
+address:        P.O. Box 10096
+address:        1001 EB Amsterdam
+address:        The Netherlands
+phone:          +31 20 535 4444
+abuse-mailbox:  abuse@ripe.net
+nic-hdl:        OPS4-RIPE
+created:        2002-09-16T10:35:13Z
+last-modified:  2024-01-12T09:42:55Z
+source:         RIPE
+
+% This query was served by the RIPE Database Query Service version 1.114 (SHETLAND)
+`
+	assert.True(t, isASWhois(input))
+
+	result, err := ParseASWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, "3333", result.AS.Number)
+	assert.Equal(t, "RIPE-NCC-AS", result.AS.Name)
+	assert.Equal(t, "ripe", result.AS.Registry)
+	assert.Equal(t, "abuse@ripe.net", result.AS.Abuse.AbuseMailbox)
+
+	result, err = Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, "3333", result.AS.Number)
+
+	result, err = ParseFor("AS3333", input)
+	assert.Nil(t, err)
+	assert.Equal(t, "RIPE-NCC-AS", result.AS.Name)
+}
+
+// TestParseASWhoisRPSLContacts tests that every contact of a role is kept, the first being the single contact.
+func TestParseASWhoisRPSLContacts(t *testing.T) {
+	input := `
+aut-num:        AS64500
+as-name:        EXAMPLE-AS
+admin-c:        JD1-RIPE
+admin-c:        JS2-RIPE
+tech-c:         JD1-RIPE
+source:         RIPE
+
+person:         John Doe
+nic-hdl:        JD1-RIPE
+created:        2010-01-02T03:04:05Z
+source:         RIPE
+
+person:         Jane Smith
+nic-hdl:        JS2-RIPE
+created:        2012-06-07T08:09:10Z
+source:         RIPE
+`
+	result, err := ParseASWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, "John Doe", result.AS.Administrative.Name)
+	assert.Equal(t, 2, len(result.AS.AdministrativeContacts))
+	assert.Equal(t, "John Doe", result.AS.AdministrativeContacts[0].Name)
+	assert.Equal(t, "Jane Smith", result.AS.AdministrativeContacts[1].Name)
+	assert.Equal(t, "2012-06-07T08:09:10Z", result.AS.AdministrativeContacts[1].RegistrationDateInTime.Format(time.RFC3339))
+	assert.Equal(t, 1, len(result.AS.TechnicalContacts))
+	assert.Equal(t, 0, len(result.AS.RoutingContacts))
+	assert.True(t, result.AS.Routing == nil)
+}
+
+// TestParseASWhoisLACNIC tests parsing of LACNIC AS WHOIS information.
+func TestParseASWhoisLACNIC(t *testing.T) {
+	input := `
+% Joint Whois - whois.lacnic.net
+%  This server accepts single ASN, IPv4 or IPv6 queries
+
+% LACNIC resource: whois.lacnic.net
+
+aut-num:     AS28000
+owner:       LACNIC - Latin American and Caribbean IP address
+ownerid:     UY-LACN-LACNIC
+responsible: Carlos Martinez
+address:     Rambla Rep Mexico, 6125, Carrasco
+address:     11400 - Montevideo -
+country:     UY
+phone:       +598 26042222 []
+owner-c:     GIA
+routing-c:   GIA
+abuse-c:     GIA
+created:     20020325
+changed:     20240805
+
+nic-hdl:     GIA
+person:      Gerardo Rada
+e-mail:      gerardo@lacnic.net
+address:     Rambla Mexico, 6125,
+address:     11400 - Montevideo -
+country:     UY
+phone:       +598 26042222 [4112]
+created:     20030414
+changed:     20230720
+`
+	result, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, "28000", result.AS.Number)
+	assert.Equal(t, "LACNIC - Latin American and Caribbean IP address", result.AS.Organization.Organization)
+	assert.Equal(t, "UY-LACN-LACNIC", result.AS.Organization.ID)
+	assert.Equal(t, "Carlos Martinez", result.AS.Organization.Name)
+	assert.Equal(t, "Gerardo Rada", result.AS.Administrative.Name)
+	assert.Equal(t, "GIA", result.AS.Routing.ID)
+	assert.Equal(t, "gerardo@lacnic.net", result.AS.Abuse.Email)
+	assert.Equal(t, "2002-03-25T00:00:00Z", result.AS.RegDateInTime.Format(time.RFC3339))
+	assert.Equal(t, "2024-08-05T00:00:00Z", result.AS.UpdatedInTime.Format(time.RFC3339))
+	assert.Equal(t, "2023-07-20T00:00:00Z", result.AS.Abuse.UpdatedInTime.Format(time.RFC3339))
+	assert.Equal(t, 0, len(result.AS.UnresolvedHandles))
+}
+
+// TestParseASWhoisAFRINIC tests parsing of AFRINIC AS WHOIS information.
+func TestParseASWhoisAFRINIC(t *testing.T) {
+	input := `
+% This is the AfriNIC Whois server.
+% The AFRINIC whois database is subject to  the following terms of Use. See https://afrinic.net/whois/terms
+
+% Information related to 'AS33764'
+
+aut-num:        AS33764
+as-name:        AFRINIC-ZA-JNB-AS
+descr:          AFRINIC Operations Services
+org:            ORG-AFNC1-AFRINIC
+admin-c:        AIC1-AFRINIC
+tech-c:         AIC1-AFRINIC
+mnt-by:         AFRINIC-HM-MNT
+status:         ASSIGNED
+created:        2005-07-04T12:00:00Z
+last-modified:  2020-11-12T06:40:04Z
+source:         AFRINIC # Filtered
+
+organisation:   ORG-AFNC1-AFRINIC
+org-name:       African Network Information Center - (AFRINIC)
+org-type:       RIR
+country:        MU
+abuse-c:        AIC2-AFRINIC
+source:         AFRINIC # Filtered
+
+role:           AFRINIC IT Contact
+e-mail:         it@afrinic.net
+nic-hdl:        AIC1-AFRINIC
+source:         AFRINIC # Filtered
+`
+	result, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, "33764", result.AS.Number)
+	assert.Equal(t, "AFRINIC-ZA-JNB-AS", result.AS.Name)
+	assert.Equal(t, "AFRINIC", result.AS.Source)
+	assert.Equal(t, "African Network Information Center - (AFRINIC)", result.AS.Organization.Organization)
+	assert.Equal(t, "it@afrinic.net", result.AS.Technical.Email)
+	assert.Equal(t, "2020-11-12T06:40:04Z", result.AS.UpdatedInTime.Format(time.RFC3339))
+	assert.True(t, result.AS.Abuse == nil)
+	assert.Equal(t, []string{"AIC2-AFRINIC"}, result.AS.UnresolvedHandles)
+}
+
+// TestParseASWhoisRequiredFields tests the fields required by registry and the wrapped errors.
+func TestParseASWhoisRequiredFields(t *testing.T) {
+	_, err := ParseASWhois("ASNumber:       7132\nASName:         SBIS-AS\n")
+	assert.True(t, errors.Is(err, ErrASDataInvalid))
+	assert.Equal(t, err.Error(), "whoisparser: AS whois data is invalid: ASHandle is missing")
+
+	_, err = ParseASWhois("ASName:         SBIS-AS\nASHandle:       AS7132\n")
+	assert.True(t, errors.Is(err, ErrASDataInvalid))
+	assert.Equal(t, err.Error(), "whoisparser: AS whois data is invalid: ASNumber is missing")
+
+	result, err := ParseASWhois("aut-num:        AS3333\nas-name:        RIPE-NCC-AS\norg:            ORG-RIEN1-RIPE\nsource:         RIPE\n")
+	assert.Nil(t, err)
+	assert.Equal(t, result.AS.Number, "3333")
+	assert.Equal(t, result.AS.Handle, "AS3333")
+	assert.Equal(t, []string{"ORG-RIEN1-RIPE"}, result.AS.UnresolvedHandles)
+
+	_, err = ParseASWhois("aut-num:        AS3333\nsource:         RIPE\n")
+	assert.True(t, errors.Is(err, ErrASDataInvalid))
+	assert.Equal(t, err.Error(), "whoisparser: AS whois data is invalid: as-name is missing")
+
+	result, err = ParseASWhois("aut-num:     AS28000\nowner:       LACNIC\n\n% whois.lacnic.net\n")
+	assert.Nil(t, err)
+	assert.Equal(t, result.AS.Handle, "AS28000")
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ASN is an autonomous system number
+type ASN uint32
+
+// ASNClass is the class of AS number in the IANA special-purpose AS numbers registry
+type ASNClass string
+
+const (
+	// ASNClassPublic is an AS number not in the special-purpose registry
+	ASNClassPublic ASNClass = "public"
+	// ASNClassPrivate is a private use AS number, 64512-65534 and 4200000000-4294967294 (RFC 6996)
+	ASNClassPrivate ASNClass = "private"
+	// ASNClassReserved is a reserved AS number, 0 (RFC 7607), 65535 and 4294967295 (RFC 7300) and 65552-131071
+	ASNClassReserved ASNClass = "reserved"
+	// ASNClassDocumentation is an AS number for documentation, 64496-64511 and 65536-65551 (RFC 5398)
+	ASNClassDocumentation ASNClass = "documentation"
+	// ASNClassASTrans is AS_TRANS, 23456 (RFC 6793)
+	ASNClassASTrans ASNClass = "as-trans"
+	// ASNClassAS112 is the AS number of the AS112 project, 112 (RFC 7534)
+	ASNClassAS112 ASNClass = "as112"
+)
+
+// asnSpecialRanges is the IANA special-purpose AS numbers registry
+var asnSpecialRanges = []struct {
+	start, end ASN
+	class      ASNClass
+}{
+	{0, 0, ASNClassReserved},
+	{112, 112, ASNClassAS112},
+	{23456, 23456, ASNClassASTrans},
+	{64496, 64511, ASNClassDocumentation},
+	{64512, 65534, ASNClassPrivate},
+	{65535, 65535, ASNClassReserved},
+	{65536, 65551, ASNClassDocumentation},
+	{65552, 131071, ASNClassReserved},
+	{4200000000, 4294967294, ASNClassPrivate},
+	{4294967295, 4294967295, ASNClassReserved},
+}
+
+// ParseASN parses AS number in asplain or asdot notation, such as "64500", "AS64500" or "AS1.10"
+func ParseASN(value string) (ASN, error) {
+	v := strings.TrimSpace(value)
+	if len(v) > 2 && strings.EqualFold(v[:2], "AS") {
+		v = v[2:]
+	}
+
+	if high, low, ok := strings.Cut(v, "."); ok {
+		h, err := strconv.ParseUint(high, 10, 16)
+		if err != nil {
+			return 0, fmt.Errorf("%w: invalid AS number %q", ErrASDataInvalid, value)
+		}
+		l, err := strconv.ParseUint(low, 10, 16)
+		if err != nil {
+			return 0, fmt.Errorf("%w: invalid AS number %q", ErrASDataInvalid, value)
+		}
+		return ASN(h<<16 | l), nil
+	}
+
+	n, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid AS number %q", ErrASDataInvalid, value)
+	}
+
+	return ASN(n), nil
+}
+
+// ParseASNRange parses AS number range, such as "7018 - 7019" or "AS3154 - AS3353",
+// a single AS number is returned as range of itself
+func ParseASNRange(value string) (start, end ASN, err error) {
+	first, last, ok := strings.Cut(value, "-")
+	if !ok {
+		start, err = ParseASN(value)
+		if err != nil {
+			return 0, 0, err
+		}
+		return start, start, nil
+	}
+
+	if start, err = ParseASN(first); err != nil {
+		return 0, 0, err
+	}
+
+	if end, err = ParseASN(last); err != nil {
+		return 0, 0, err
+	}
+
+	if start > end {
+		return 0, 0, fmt.Errorf("%w: invalid AS number range %q", ErrASDataInvalid, value)
+	}
+
+	return start, end, nil
+}
+
+// String returns the AS number in asplain notation with the AS prefix, such as "AS64500"
+func (a ASN) String() string {
+	return "AS" + strconv.FormatUint(uint64(a), 10)
+}
+
+// ASDot returns the AS number in asdot notation with the AS prefix, such as "AS1.10",
+// the AS numbers of 2 bytes are the same as in asplain notation
+func (a ASN) ASDot() string {
+	if !a.Is4Byte() {
+		return a.String()
+	}
+
+	return fmt.Sprintf("AS%d.%d", a>>16, a&0xffff)
+}
+
+// Is4Byte returns if the AS number does not fit in 2 bytes
+func (a ASN) Is4Byte() bool {
+	return a > 65535
+}
+
+// Class returns the class of the AS number in the IANA special-purpose AS numbers registry
+func (a ASN) Class() ASNClass {
+	for _, v := range asnSpecialRanges {
+		if a >= v.start && a <= v.end {
+			return v.class
+		}
+	}
+
+	return ASNClassPublic
+}
@@ -0,0 +1,115 @@
+package whoisparser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseASN tests that AS numbers are parsed in asplain and asdot notation.
+func TestParseASN(t *testing.T) {
+	tests := []struct {
+		value string
+		asn   ASN
+		valid bool
+	}{
+		{"7018", 7018, true},
+		{"AS7018", 7018, true},
+		{"as7018", 7018, true},
+		{" AS7018 ", 7018, true},
+		{"AS1.10", 65546, true},
+		{"0.65535", 65535, true},
+		{"4294967295", 4294967295, true},
+		{"AS65535.65535", 4294967295, true},
+		{"4294967296", 0, false},
+		{"AS1.65536", 0, false},
+		{"AS65536.1", 0, false},
+		{"AS-HURRICANE", 0, false},
+		{"AS", 0, false},
+		{"", 0, false},
+	}
+
+	for _, v := range tests {
+		asn, err := ParseASN(v.value)
+		if v.valid {
+			assert.Nil(t, err, v.value)
+		} else {
+			assert.True(t, errors.Is(err, ErrASDataInvalid), v.value)
+		}
+		assert.Equal(t, v.asn, asn, v.value)
+	}
+}
+
+// TestParseASNRange tests that AS number ranges and single AS numbers are parsed.
+func TestParseASNRange(t *testing.T) {
+	tests := []struct {
+		value      string
+		start, end ASN
+		valid      bool
+	}{
+		{"7018 - 7019", 7018, 7019, true},
+		{"AS3154 - AS3353", 3154, 3353, true},
+		{"AS1.0-AS1.10", 65536, 65546, true},
+		{"AS7018", 7018, 7018, true},
+		{"7019 - 7018", 0, 0, false},
+		{"AS3154 - ", 0, 0, false},
+		{"N/A", 0, 0, false},
+	}
+
+	for _, v := range tests {
+		start, end, err := ParseASNRange(v.value)
+		if v.valid {
+			assert.Nil(t, err, v.value)
+		} else {
+			assert.True(t, errors.Is(err, ErrASDataInvalid), v.value)
+		}
+		assert.Equal(t, v.start, start, v.value)
+		assert.Equal(t, v.end, end, v.value)
+	}
+}
+
+// TestASNFormat tests that AS numbers are formatted in asplain and asdot notation.
+func TestASNFormat(t *testing.T) {
+	assert.Equal(t, "AS7018", ASN(7018).String())
+	assert.Equal(t, "AS7018", ASN(7018).ASDot())
+	assert.Equal(t, "AS65535", ASN(65535).ASDot())
+	assert.Equal(t, "AS1.0", ASN(65536).ASDot())
+	assert.Equal(t, "AS1.10", ASN(65546).ASDot())
+	assert.Equal(t, "AS65546", ASN(65546).String())
+	assert.False(t, ASN(65535).Is4Byte())
+	assert.True(t, ASN(65536).Is4Byte())
+}
+
+// TestASNClass tests that AS numbers are classified by the IANA special-purpose registry.
+func TestASNClass(t *testing.T) {
+	tests := []struct {
+		asn   ASN
+		class ASNClass
+	}{
+		{0, ASNClassReserved},
+		{1, ASNClassPublic},
+		{112, ASNClassAS112},
+		{7018, ASNClassPublic},
+		{23456, ASNClassASTrans},
+		{64495, ASNClassPublic},
+		{64496, ASNClassDocumentation},
+		{64511, ASNClassDocumentation},
+		{64512, ASNClassPrivate},
+		{65534, ASNClassPrivate},
+		{65535, ASNClassReserved},
+		{65536, ASNClassDocumentation},
+		{65551, ASNClassDocumentation},
+		{65552, ASNClassReserved},
+		{131071, ASNClassReserved},
+		{131072, ASNClassPublic},
+		{4199999999, ASNClassPublic},
+		{4200000000, ASNClassPrivate},
+		{4294967294, ASNClassPrivate},
+		{4294967295, ASNClassReserved},
+	}
+
+	for _, v := range tests {
+		assert.Equal(t, v.class, v.asn.Class(), v.asn.String())
+	}
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"net/netip"
+	"regexp"
+	"strings"
+)
+
+var (
+	cymruHeaderRx = regexp.MustCompile(`(?i)^AS\s*\|`)
+	cymruBulkRx   = regexp.MustCompile(`(?i)^Bulk mode;`)
+	cymruRowRx    = regexp.MustCompile(`(?i)^(?:\d+|NA)\s*\|`)
+)
+
+// cymruColumns is the columns of Team Cymru output without header by their count,
+// as returned in bulk mode for IP queries, with -p for prefixes, and for AS queries
+var cymruColumns = map[int][]string{
+	2: {"as", "as name"},
+	3: {"as", "ip", "as name"},
+	4: {"as", "ip", "bgp prefix", "as name"},
+	5: {"as", "cc", "registry", "allocated", "as name"},
+	7: {"as", "ip", "bgp prefix", "cc", "registry", "allocated", "as name"},
+}
+
+// isCymruWhois returns if the whois information is Team Cymru IP to ASN mapping output,
+// made of pipe separated lines with a header line or the bulk mode line
+func isCymruWhois(text string) bool {
+	rows := 0
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+			continue
+		case cymruHeaderRx.MatchString(line), cymruBulkRx.MatchString(line):
+			return true
+		case cymruRowRx.MatchString(line):
+			rows++
+		default:
+			return false
+		}
+	}
+
+	return rows > 0
+}
+
+// ParseCymruWhois parses Team Cymru IP to ASN mapping output in normal, verbose and bulk mode,
+// such as "15169 | 8.8.8.8 | 8.8.8.0/24 | US | arin | 1992-12-01 | GOOGLE, US",
+// a query with multiple origin AS numbers returns a record for each of them
+func ParseCymruWhois(text string) (whoisInfo WhoisInfo, err error) {
+	var columns []string
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || cymruBulkRx.MatchString(line) || strings.HasPrefix(line, "Error:") {
+			continue
+		}
+
+		values := strings.Split(line, "|")
+		for i, v := range values {
+			values[i] = strings.TrimSpace(v)
+		}
+
+		if cymruHeaderRx.MatchString(line) {
+			columns = make([]string, len(values))
+			for i, v := range values {
+				columns[i] = strings.ToLower(v)
+			}
+			continue
+		}
+
+		if len(columns) != len(values) {
+			columns = cymruColumns[len(values)]
+			if columns == nil {
+				continue
+			}
+		}
+
+		fields := map[string]string{}
+		for i, v := range values {
+			if v != "NA" {
+				fields[columns[i]] = v
+			}
+		}
+
+		if record := parseCymruRecord(fields); record != nil {
+			whoisInfo.Cymru = append(whoisInfo.Cymru, record)
+		}
+	}
+
+	if len(whoisInfo.Cymru) == 0 {
+		err = ErrIPDataInvalid
+	}
+
+	return
+}
+
+// parseCymruRecord returns record of the fields of Team Cymru output line, keyed by lowercase column name
+func parseCymruRecord(fields map[string]string) *CymruRecord {
+	record := &CymruRecord{}
+	registry := strings.ToLower(fields["registry"])
+
+	asn, err := ParseASN(fields["as"])
+	if err == nil {
+		record.Query = asn.String()
+		record.AS = &ASInfo{
+			Number: strings.TrimPrefix(asn.String(), "AS"),
+			Start:  asn,
+			End:    asn,
+			Name:   fields["as name"],
+		}
+	}
+
+	ip, ok := fields["ip"]
+	if !ok {
+		if record.AS == nil {
+			return nil
+		}
+		// the registry and allocation date are about the AS number on AS queries
+		record.AS.Source = strings.ToUpper(registry)
+		record.AS.RegDate = fields["allocated"]
+		record.AS.RegDateInTime, record.AS.RegDateZoneAssumed, record.AS.RegDateApproximate = parseDateTime(record.AS.RegDate, registry)
+		return record
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil
+	}
+
+	record.Query = addr.String()
+	record.IP = &IPInfo{
+		Networks: []*Network{},
+	}
+
+	network := &Network{
+		Country: fields["cc"],
+		Source:  strings.ToUpper(registry),
+		RegDate: fields["allocated"],
+	}
+
+	if record.AS != nil {
+		network.OriginAS = asn.String()
+	}
+
+	if prefix, err := netip.ParsePrefix(fields["bgp prefix"]); err == nil {
+		network.Range = prefix.Masked().String()
+		network.CIDR = []string{prefix.Masked().String()}
+		if record.AS != nil {
+			record.IP.Routes = append(record.IP.Routes, &Route{
+				Prefix: prefix.Masked(),
+				Origin: network.OriginAS,
+				Descr:  record.AS.Name,
+			})
+		}
+	}
+
+	if network.Range != "" || network.Source != "" || network.OriginAS != "" {
+		record.IP.Networks = append(record.IP.Networks, network)
+	}
+
+	normalizeIPNetworks(record.IP)
+	parseIPDateTimes(record.IP, registry)
+
+	return record
+}
@@ -0,0 +1,106 @@
+package whoisparser
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseCymruWhois tests that Team Cymru verbose output is parsed into IP and AS records.
+func TestParseCymruWhois(t *testing.T) {
+	input := `AS      | IP               | BGP Prefix          | CC | Registry | Allocated  | AS Name
+15169   | 8.8.8.8          | 8.8.8.0/24          | US | arin     | 2023-12-28 | GOOGLE, US
+NA      | 10.0.0.1         | NA                  |    | other    |            | NA
+`
+	assert.True(t, isCymruWhois(input))
+
+	result, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(result.Cymru))
+
+	record := result.Cymru[0]
+	assert.Equal(t, "8.8.8.8", record.Query)
+	assert.Equal(t, "15169", record.AS.Number)
+	assert.Equal(t, ASN(15169), record.AS.Start)
+	assert.Equal(t, "GOOGLE, US", record.AS.Name)
+	assert.Equal(t, 1, len(record.IP.Networks))
+
+	network := record.IP.Networks[0]
+	assert.Equal(t, "8.8.8.0 - 8.8.8.255", network.Range)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("8.8.8.0/24")}, network.Prefixes)
+	assert.Equal(t, "AS15169", network.OriginAS)
+	assert.Equal(t, "US", network.Country)
+	assert.Equal(t, "ARIN", network.Source)
+	assert.Equal(t, "2023-12-28", network.RegDate)
+	assert.Equal(t, 2023, network.RegDateInTime.Year())
+	assert.Equal(t, 1, len(record.IP.Routes))
+	assert.Equal(t, "AS15169", record.IP.Routes[0].Origin)
+
+	record = result.Cymru[1]
+	assert.Equal(t, "10.0.0.1", record.Query)
+	assert.Nil(t, record.AS)
+	assert.Equal(t, 1, len(record.IP.Networks))
+	assert.Equal(t, "OTHER", record.IP.Networks[0].Source)
+	assert.Equal(t, "", record.IP.Networks[0].Range)
+	assert.Nil(t, record.IP.Routes)
+}
+
+// TestParseCymruWhoisBulk tests that Team Cymru bulk mode output without header is parsed by column count.
+func TestParseCymruWhoisBulk(t *testing.T) {
+	input := `Bulk mode; whois.cymru.com [2024-10-01 12:00:00 +0000]
+15169   | 8.8.8.8          | 8.8.8.0/24          | US | arin     | 2023-12-28 | GOOGLE, US
+13335   | 2606:4700:4700::1111 | 2606:4700:4700::/48 | US | arin | 2011-11-01 | CLOUDFLARENET, US
+Error: no ASN or IP match on line 3.
+`
+	assert.True(t, isCymruWhois(input))
+
+	result, err := ParseCymruWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(result.Cymru))
+	assert.Equal(t, "8.8.8.8", result.Cymru[0].Query)
+	assert.Equal(t, "2606:4700:4700::1111", result.Cymru[1].Query)
+	assert.Equal(t, []string{"2606:4700:4700::/48"}, result.Cymru[1].IP.Networks[0].CIDR)
+	assert.Equal(t, "AS13335", result.Cymru[1].IP.Networks[0].OriginAS)
+
+	input = `Bulk mode; whois.cymru.com [2024-10-01 12:00:00 +0000]
+15169   | 8.8.8.8          | GOOGLE, US
+`
+	result, err = ParseCymruWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.Cymru))
+	assert.Equal(t, "GOOGLE, US", result.Cymru[0].AS.Name)
+	assert.Equal(t, "AS15169", result.Cymru[0].IP.Networks[0].OriginAS)
+	assert.Equal(t, "", result.Cymru[0].IP.Networks[0].Range)
+
+	input = `Bulk mode; whois.cymru.com [2024-10-01 12:00:00 +0000]
+Error: no ASN or IP match on line 1.
+`
+	_, err = ParseCymruWhois(input)
+	assert.Equal(t, ErrIPDataInvalid, err)
+}
+
+// TestParseCymruWhoisAS tests that Team Cymru output of AS queries is parsed into AS records.
+func TestParseCymruWhoisAS(t *testing.T) {
+	input := `AS      | CC | Registry | Allocated  | AS Name
+15169   | US | arin     | 2000-03-30 | GOOGLE, US
+`
+	result, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.Cymru))
+	assert.Equal(t, "AS15169", result.Cymru[0].Query)
+	assert.Nil(t, result.Cymru[0].IP)
+	assert.Equal(t, "ARIN", result.Cymru[0].AS.Source)
+	assert.Equal(t, "2000-03-30", result.Cymru[0].AS.RegDate)
+	assert.Equal(t, 2000, result.Cymru[0].AS.RegDateInTime.Year())
+	assert.False(t, result.Cymru[0].AS.RegDateApproximate)
+}
+
+// TestIsCymruWhois tests that other whois information is not detected as Team Cymru output.
+func TestIsCymruWhois(t *testing.T) {
+	assert.True(t, isCymruWhois("15169 | 8.8.8.8 | GOOGLE, US\n"))
+	assert.False(t, isCymruWhois("ASNumber: 7132\nASName: SBIS-AS\n"))
+	assert.False(t, isCymruWhois("inetnum: 193.0.0.0 - 193.0.7.255\nnetname: RIPE-NCC\n"))
+	assert.False(t, isCymruWhois("Domain Name: GOOGLE.COM\n"))
+	assert.False(t, isCymruWhois(""))
+}
@@ -0,0 +1,455 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	zoneJST = time.FixedZone("JST", 9*60*60)
+	zoneKST = time.FixedZone("KST", 9*60*60)
+	zoneCST = time.FixedZone("CST", 8*60*60)
+
+	// dateZoneRule is the time zone assumed for dates without zone, by extension or registry
+	dateZoneRule = map[string]*time.Location{
+		"jp":           zoneJST,
+		"kr":           zoneKST,
+		"xn--3e0b707e": zoneKST,
+		"cn":           zoneCST,
+		"xn--fiqs8s":   zoneCST,
+		"xn--fiqz9s":   zoneCST,
+		"tw":           zoneCST,
+		"xn--kpry57d":  zoneCST,
+		"xn--kprw13d":  zoneCST,
+		"jpnic":        zoneJST,
+		"krnic":        zoneKST,
+		"cnnic":        zoneCST,
+		"twnic":        zoneCST,
+		"arin":         time.UTC,
+		"ripe":         time.UTC,
+		"apnic":        time.UTC,
+		"afrinic":      time.UTC,
+		"lacnic":       time.UTC,
+	}
+
+	// dateZoneAbbr is the offset in hours of time zone abbreviations used by registries
+	dateZoneAbbr = map[string]float64{
+		"UT":   0,
+		"UTC":  0,
+		"GMT":  0,
+		"WET":  0,
+		"WEST": 1,
+		"BST":  1,
+		"CET":  1,
+		"CEST": 2,
+		"EET":  2,
+		"EEST": 3,
+		"MSK":  3,
+		"IST":  5.5,
+		"ICT":  7,
+		"WIB":  7,
+		"HKT":  8,
+		"SGT":  8,
+		"AWST": 8,
+		"JST":  9,
+		"KST":  9,
+		"ACST": 9.5,
+		"AEST": 10,
+		"AEDT": 11,
+		"NZST": 12,
+		"NZDT": 13,
+		"BRT":  -3,
+		"ART":  -3,
+		"CLST": -3,
+		"CLT":  -4,
+		"AST":  -4,
+		"EDT":  -4,
+		"EST":  -5,
+		"CDT":  -5,
+		"CST":  -6,
+		"MDT":  -6,
+		"MST":  -7,
+		"PDT":  -7,
+		"PST":  -8,
+	}
+)
+
+// dateFormats is the default layouts tried in order by DateParser
+var dateFormats = []string{
+	// Date & time formats
+	"2006-01-02 15:04:05",
+	"2006.01.02 15:04:05",
+	"2006/01/02 15:04:05",
+	"02/01/2006 15:04:05",
+	"01/02/2006 15:04:05",
+	"02.01.2006 15:04:05",
+	"02.1.2006 15:04:05",
+	"2.1.2006 15:04:05",
+	"02-Jan-2006 15:04:05",
+	"20060102 15:04:05",
+	time.ANSIC,
+	time.Stamp,
+	time.StampMilli,
+	time.StampMicro,
+	time.StampNano,
+
+	// Date, time & time zone formats
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02 15:04:05-07",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05 (MST+3)",
+	time.UnixDate,
+	time.RubyDate,
+	time.RFC822,
+	time.RFC822Z,
+	time.RFC850,
+	time.RFC1123,
+	time.RFC1123Z,
+	time.RFC3339,
+	time.RFC3339Nano,
+
+	// Date only formats
+	"2006-01-02",
+	"02-Jan-2006",
+	"02.01.2006",
+	"02-01-2006",
+	"January _2 2006",
+	"Mon Jan _2 2006",
+	"_2 January 2006",
+	"_2 Jan 2006",
+	"02/01/2006",
+	"01/02/2006",
+	"2006/01/02",
+	"2006-Jan-02",
+	"20060102",
+
+	// Partial date formats
+	"Jan-2006",
+	"January 2006",
+	"Jan 2006",
+	"2006-01",
+}
+
+// dateOrderFormats is the day first layouts paired with their month first counterparts
+var dateOrderFormats = map[string]string{
+	"02/01/2006 15:04:05": "01/02/2006 15:04:05",
+	"01/02/2006 15:04:05": "02/01/2006 15:04:05",
+	"02/01/2006":          "01/02/2006",
+	"01/02/2006":          "02/01/2006",
+}
+
+// dateMonthFirstRule is the extensions and registries writing numeric dates month first
+var dateMonthFirstRule = map[string]bool{
+	"tk":   true,
+	"ml":   true,
+	"ga":   true,
+	"cf":   true,
+	"gq":   true,
+	"arin": true,
+}
+
+// dateMonthRule is the mapper of non-English month names to English abbreviations,
+// it covers Polish, Czech, Russian, Turkish, Spanish, Portuguese, German and French
+var dateMonthRule = map[string]string{
+	// Polish
+	"styczeń": "Jan", "stycznia": "Jan", "luty": "Feb", "lutego": "Feb", "marzec": "Mar", "marca": "Mar",
+	"kwiecień": "Apr", "kwietnia": "Apr", "maj": "May", "maja": "May", "czerwiec": "Jun", "czerwca": "Jun",
+	"lipiec": "Jul", "lipca": "Jul", "sierpień": "Aug", "sierpnia": "Aug", "wrzesień": "Sep", "września": "Sep",
+	"październik": "Oct", "października": "Oct", "listopada": "Nov", "grudzień": "Dec", "grudnia": "Dec",
+	// Czech
+	"leden": "Jan", "ledna": "Jan", "únor": "Feb", "února": "Feb", "březen": "Mar", "března": "Mar",
+	"duben": "Apr", "dubna": "Apr", "květen": "May", "května": "May", "červen": "Jun", "června": "Jun",
+	"červenec": "Jul", "července": "Jul", "srpen": "Aug", "srpna": "Aug", "září": "Sep", "říjen": "Oct",
+	"října": "Oct", "listopad": "Nov", "listopadu": "Nov", "prosinec": "Dec", "prosince": "Dec",
+	// Russian
+	"январь": "Jan", "января": "Jan", "янв": "Jan", "февраль": "Feb", "февраля": "Feb", "фев": "Feb",
+	"март": "Mar", "марта": "Mar", "мар": "Mar", "апрель": "Apr", "апреля": "Apr", "апр": "Apr",
+	"май": "May", "мая": "May", "июнь": "Jun", "июня": "Jun", "июн": "Jun", "июль": "Jul", "июля": "Jul",
+	"июл": "Jul", "август": "Aug", "августа": "Aug", "авг": "Aug", "сентябрь": "Sep", "сентября": "Sep",
+	"сен": "Sep", "октябрь": "Oct", "октября": "Oct", "окт": "Oct", "ноябрь": "Nov", "ноября": "Nov",
+	"ноя": "Nov", "декабрь": "Dec", "декабря": "Dec", "дек": "Dec",
+	// Turkish
+	"ocak": "Jan", "şubat": "Feb", "mart": "Mar", "nisan": "Apr", "mayıs": "May", "haziran": "Jun",
+	"temmuz": "Jul", "ağustos": "Aug", "eylül": "Sep", "ekim": "Oct", "kasım": "Nov", "aralık": "Dec",
+	// Spanish
+	"enero": "Jan", "ene": "Jan", "febrero": "Feb", "marzo": "Mar", "abril": "Apr", "abr": "Apr",
+	"mayo": "May", "junio": "Jun", "julio": "Jul", "agosto": "Aug", "ago": "Aug", "septiembre": "Sep",
+	"setiembre": "Sep", "octubre": "Oct", "noviembre": "Nov", "diciembre": "Dec", "dic": "Dec",
+	// Portuguese
+	"janeiro": "Jan", "fevereiro": "Feb", "fev": "Feb", "março": "Mar", "maio": "May", "mai": "May",
+	"junho": "Jun", "julho": "Jul", "setembro": "Sep", "set": "Sep", "outubro": "Oct", "out": "Oct",
+	"novembro": "Nov", "dezembro": "Dec", "dez": "Dec",
+	// German
+	"januar": "Jan", "jän": "Jan", "jänner": "Jan", "februar": "Feb", "märz": "Mar", "mär": "Mar",
+	"juni": "Jun", "juli": "Jul", "oktober": "Oct", "okt": "Oct", "dezember": "Dec",
+	// French
+	"janvier": "Jan", "janv": "Jan", "février": "Feb", "févr": "Feb", "mars": "Mar", "avril": "Apr",
+	"avr": "Apr", "juin": "Jun", "juillet": "Jul", "juil": "Jul", "août": "Aug", "septembre": "Sep",
+	"sept": "Sep", "octobre": "Oct", "novembre": "Nov", "décembre": "Dec", "déc": "Dec",
+	// Fillers
+	"de": "", "del": "", "г": "", "года": "", "er": "",
+}
+
+// ParsedDate stores a parsed date and how it was parsed
+type ParsedDate struct {
+	// Time is the parsed time
+	Time time.Time
+	// Layout is the layout matched the date
+	Layout string
+	// ZoneExplicit is whether the time zone was given, or assumed by the extension or registry
+	ZoneExplicit bool
+	// Approximate is whether the date is only partially known, such as "before Aug-1996"
+	Approximate bool
+}
+
+// DateParser parses whois dates with a list of layouts, some of them may be scoped
+// to extensions or registries such as "jp" or "ripe"
+type DateParser struct {
+	mutex   sync.RWMutex
+	formats []dateLayout
+	scoped  map[string][]dateLayout
+}
+
+// dateLayout is a layout of DateParser with its properties computed once
+type dateLayout struct {
+	layout  string
+	zone    bool
+	partial bool
+}
+
+// defaultDateLayouts is the default layouts with their properties
+var defaultDateLayouts = newDateLayouts(dateFormats)
+
+// DefaultDateParser is the date parser used for parsing whois information,
+// formats registered to it apply to all the parsed whois dates
+var DefaultDateParser = NewDateParser()
+
+var (
+	dateZoneSuffixRx = regexp.MustCompile(`^(.*\d)(?:\s*\(([^()]+)\)|\s+([A-Z]{2,4}|(?:UTC|GMT)\s*[+-][\d:]+))$`)
+	dateZoneOffsetRx = regexp.MustCompile(`^(?:UTC|GMT)?\s*([+-])(\d{1,2})(?::?(\d{2}))?$`)
+	dateBeforeRx     = regexp.MustCompile(`(?i)^before\s+`)
+	dateWordRx       = regexp.MustCompile(`\pL+`)
+	dateDayDotRx     = regexp.MustCompile(`(\d)\.\s*([A-Z][a-z]{2})\b`)
+)
+
+// NewDateParser returns a new date parser with the default layouts
+func NewDateParser() *DateParser {
+	return &DateParser{
+		formats: append([]dateLayout{}, defaultDateLayouts...),
+		scoped:  map[string][]dateLayout{},
+	}
+}
+
+// RegisterFormat registers a layout as used by time.Parse, if extensions or registries are given
+// the layout is only tried for them. Registered layouts are tried before the existing ones.
+func (p *DateParser) RegisterFormat(layout string, exts ...string) {
+	v := newDateLayout(layout)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if len(exts) == 0 {
+		p.formats = append([]dateLayout{v}, p.formats...)
+		return
+	}
+
+	for _, ext := range exts {
+		ext = strings.ToLower(ext)
+		p.scoped[ext] = append([]dateLayout{v}, p.scoped[ext]...)
+	}
+}
+
+// Parse attempts to parse a given date using the layouts scoped to the extension
+// or registry first, then the common layouts. Date layouts containing time components
+// are tried before date-only layouts. Dates without time zone are taken in the time zone
+// of the extension or registry, or UTC by default. Zero time is returned if the date
+// could not be parsed.
+func (p *DateParser) Parse(value, ext string) (ParsedDate, error) {
+	datetime := value
+	ext = strings.ToLower(ext)
+
+	approximate := false
+	if dateBeforeRx.MatchString(datetime) {
+		datetime = dateBeforeRx.ReplaceAllString(datetime, "")
+		approximate = true
+	}
+
+	datetime = translateDateMonth(datetime)
+	datetime = strings.Trim(datetime, ". ")
+	datetime = strings.ReplaceAll(datetime, ". ", "-")
+
+	loc := time.UTC
+	if v, ok := dateZoneRule[ext]; ok {
+		loc = v
+	}
+
+	p.mutex.RLock()
+	formats := append(append([]dateLayout{}, p.scoped[ext]...), p.formats...)
+	p.mutex.RUnlock()
+
+	zoneExplicit := false
+	if m := dateZoneSuffixRx.FindStringSubmatch(datetime); len(m) > 0 {
+		if v, ok := searchDateZone(m[2]+m[3], loc); ok {
+			if result, ok := parseDateLayouts(formats, m[1], ext, v); ok {
+				result.ZoneExplicit = true
+				result.Approximate = result.Approximate || approximate
+				return result, nil
+			}
+			// the zone is part of layouts such as RFC 1123, the location is named as the zone to resolve it
+			loc, zoneExplicit = v, true
+		}
+	}
+
+	if result, ok := parseDateLayouts(formats, datetime, ext, loc); ok {
+		result.ZoneExplicit = result.ZoneExplicit || zoneExplicit
+		result.Approximate = result.Approximate || approximate
+		return result, nil
+	}
+
+	return ParsedDate{}, fmt.Errorf("could not parse %s as a date", value)
+}
+
+// parseDateLayouts returns the date parsed by the first matching layout, in the location if it has no time zone
+func parseDateLayouts(formats []dateLayout, datetime, ext string, loc *time.Location) (ParsedDate, bool) {
+	for _, layout := range formats {
+		format := layout.layout
+		if v, ok := dateOrderFormats[format]; ok && dateMonthFirstRule[ext] {
+			format = v
+		}
+		result, err := time.ParseInLocation(format, datetime, loc)
+		if err != nil {
+			continue
+		}
+		return ParsedDate{
+			Time:         result,
+			Layout:       format,
+			ZoneExplicit: layout.zone,
+			Approximate:  layout.partial,
+		}, true
+	}
+
+	return ParsedDate{}, false
+}
+
+// parseDateString parses a given date with the default date parser
+func parseDateString(datetime, ext string) (ParsedDate, error) {
+	return DefaultDateParser.Parse(datetime, ext)
+}
+
+// parseDateTime returns the parsed time of a given date, if its time zone is assumed by the registry
+// and if it is approximate, or nil if it is empty or could not be parsed
+func parseDateTime(datetime, registry string) (*time.Time, bool, bool) {
+	if datetime == "" {
+		return nil, false, false
+	}
+
+	parsed, err := parseDateString(datetime, registry)
+	if err != nil {
+		return nil, false, false
+	}
+
+	return &parsed.Time, !parsed.ZoneExplicit, parsed.Approximate
+}
+
+// translateDateMonth returns the date with non-English month names replaced by English abbreviations
+func translateDateMonth(datetime string) string {
+	translated := false
+	datetime = dateWordRx.ReplaceAllStringFunc(datetime, func(word string) string {
+		if v, ok := dateMonthRule[strings.ToLower(word)]; ok {
+			translated = true
+			return v
+		}
+		return word
+	})
+
+	if !translated {
+		return datetime
+	}
+
+	datetime = dateDayDotRx.ReplaceAllString(datetime, "$1 $2")
+	datetime = strings.Join(strings.Fields(datetime), " ")
+
+	return datetime
+}
+
+// searchDateZone returns the location of a time zone abbreviation or offset,
+// an abbreviation named as the default location of the registry resolves to it
+func searchDateZone(zone string, loc *time.Location) (*time.Location, bool) {
+	zone = strings.TrimSpace(zone)
+	if zone == loc.String() {
+		return loc, true
+	}
+
+	if v, ok := dateZoneAbbr[zone]; ok {
+		return time.FixedZone(zone, int(v*60*60)), true
+	}
+
+	m := dateZoneOffsetRx.FindStringSubmatch(zone)
+	if len(m) == 0 {
+		return nil, false
+	}
+
+	hours, _ := strconv.Atoi(m[2])
+	minutes, _ := strconv.Atoi(m[3])
+	offset := hours*60*60 + minutes*60
+	if m[1] == "-" {
+		offset = -offset
+	}
+
+	return time.FixedZone("", offset), true
+}
+
+// newDateLayout returns the layout with its properties
+func newDateLayout(layout string) dateLayout {
+	return dateLayout{
+		layout:  layout,
+		zone:    isZoneLayout(layout),
+		partial: isPartialLayout(layout),
+	}
+}
+
+// newDateLayouts returns the layouts with their properties
+func newDateLayouts(layouts []string) []dateLayout {
+	result := make([]dateLayout, len(layouts))
+	for i, layout := range layouts {
+		result[i] = newDateLayout(layout)
+	}
+
+	return result
+}
+
+// isZoneLayout returns if the time layout contains a time zone
+func isZoneLayout(layout string) bool {
+	return containsIn(layout, []string{"MST", "Z07", "-07"})
+}
+
+// isPartialLayout returns if the time layout has no day, such as "Jan-2006"
+func isPartialLayout(layout string) bool {
+	day := time.Date(2001, 2, 3, 0, 0, 0, 0, time.UTC)
+	parsed, err := time.Parse(layout, day.Format(layout))
+
+	return err == nil && parsed.Day() != day.Day()
+}
@@ -0,0 +1,185 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/likexian/gokit/assert"
+)
+
+// https://github.com/golang/go/wiki/TableDrivenTests
+func TestParseDateString(t *testing.T) {
+	t.Parallel() // marks TLog as capable of running in parallel with other tests
+	tests := []struct {
+		date string
+	}{
+		{"09-Mar-2023"},
+		{"31-Jul-2022"},
+		{"2022-12-12T11:01:02Z"},
+		{"2022-12-03"},
+		{"2022. 12. 01."},
+		{"2022-12-12 11:40:12"},
+		{"2022.12.12 11:40:12"},
+		{"28/06/2022 23:59:59"},
+		{"24.10.2022"},
+		{"2022-06-29 14:08:21+03"},
+		{"31.8.2025 00:00:00"},
+		{"01-10-2025"},
+		{"20-Apr-2023 03:28:40"},
+		{"2022-12-08 14:00:00 CLST"},
+		{"December  2 2022"},
+		{"Mon Jan  2 2006"},
+		{"02/28/2025"},
+		{"2001/03/22"},
+		{"April 10 2023"},
+		{"2025-Dec-11"},
+		{"2025-Dec-11."},
+		{"2024-06-05 00:00:00 (UTC+8)"},
+		{"20221101 00:10:24"},
+		{"Mon, 02 Jan 2006 15:04:05 GMT"},
+		{"02 Jan 06 15:04 MST"},
+		{"Monday, 02-Jan-06 15:04:05 MST"},
+		{"2006-01-02 15:04:05 (MST+3)"},
+	}
+
+	for _, tt := range tests {
+		tt := tt // NOTE: https://github.com/golang/go/wiki/CommonMistakes#using-goroutines-on-loop-iterator-variables
+		t.Run(tt.date, func(t *testing.T) {
+			t.Parallel() // marks each test case as capable of running in parallel with each other
+			_, err := parseDateString(tt.date, "")
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestParseDateStringZone(t *testing.T) {
+	tests := []struct {
+		date     string
+		ext      string
+		expected string
+		explicit bool
+	}{
+		{"2022-12-12T11:01:02Z", "", "2022-12-12T11:01:02Z", true},
+		{"2019-09-09T08:39:04-0700", "com", "2019-09-09T08:39:04-07:00", true},
+		{"2022-12-12 11:40:12", "", "2022-12-12T11:40:12Z", false},
+		{"2020-03-17 12:48:36", "cn", "2020-03-17T12:48:36+08:00", false},
+		{"2020-03-17 12:48:36 CST", "cn", "2020-03-17T12:48:36+08:00", true},
+		{"2007. 03. 02.", "kr", "2007-03-02T00:00:00+09:00", false},
+		{"2023/04/01 01:04:55 (JST)", "jp", "2023-04-01T01:04:55+09:00", true},
+		{"2001/02/19 18:48:02(JST)", "jpnic", "2001-02-19T18:48:02+09:00", true},
+		{"2023/04/01 01:04:55 (JST)", "", "2023-04-01T01:04:55+09:00", true},
+		{"2024-06-05 00:00:00 (UTC+8)", "", "2024-06-05T00:00:00+08:00", true},
+		{"1999-06-07 13:01:43 (GMT+0:00)", "tw", "1999-06-07T13:01:43Z", true},
+		{"2022-12-08 14:00:00 CLST", "", "2022-12-08T14:00:00-03:00", true},
+		{"2022-12-03", "ripe", "2022-12-03T00:00:00Z", false},
+		{"Mon, 02 Jan 2006 15:04:05 GMT", "", "2006-01-02T15:04:05Z", true},
+		{"02 Jan 06 15:04 MST", "", "2006-01-02T15:04:00-07:00", true},
+		{"Monday, 02-Jan-06 15:04:05 MST", "", "2006-01-02T15:04:05-07:00", true},
+	}
+
+	for _, tt := range tests {
+		parsed, err := parseDateString(tt.date, tt.ext)
+		assert.Nil(t, err, tt.date)
+		assert.Equal(t, parsed.Time.Format(time.RFC3339), tt.expected, tt.date)
+		assert.Equal(t, parsed.ZoneExplicit, tt.explicit, tt.date)
+	}
+}
+
+func TestParseDateStringLocale(t *testing.T) {
+	tests := []struct {
+		date        string
+		ext         string
+		expected    string
+		approximate bool
+	}{
+		{"03/02/2020", "", "2020-02-03", false},
+		{"03/02/2020", "tk", "2020-03-02", false},
+		{"11/29/2016", "tk", "2016-11-29", false},
+		{"03/02/2020", "arin", "2020-03-02", false},
+		{"03/02/2020 10:30:00", "arin", "2020-03-02", false},
+		{"03/02/2020", "ripe", "2020-02-03", false},
+		{"12 stycznia 2020", "pl", "2020-01-12", false},
+		{"5. června 2019", "cz", "2019-06-05", false},
+		{"1 января 2020 г.", "ru", "2020-01-01", false},
+		{"14 Şubat 2021", "tr", "2021-02-14", false},
+		{"5 de enero de 2020", "es", "2020-01-05", false},
+		{"7 de março de 2018", "br", "2018-03-07", false},
+		{"1. Dezember 2017", "de", "2017-12-01", false},
+		{"1er août 2015", "fr", "2015-08-01", false},
+		{"before Aug-1996", "uk", "1996-08-01", true},
+		{"before 1 January 1996", "ch", "1996-01-01", true},
+		{"Jan-2006", "", "2006-01-01", true},
+		{"2006-01", "", "2006-01-01", true},
+	}
+
+	for _, tt := range tests {
+		parsed, err := parseDateString(tt.date, tt.ext)
+		assert.Nil(t, err, tt.date)
+		assert.Equal(t, parsed.Time.Format("2006-01-02"), tt.expected, tt.date)
+		assert.Equal(t, parsed.Approximate, tt.approximate, tt.date)
+	}
+
+	parsed, err := parseDateString("not a date", "")
+	assert.NotNil(t, err)
+	assert.True(t, parsed.Time.IsZero())
+}
+
+func TestDateParser(t *testing.T) {
+	parsed, err := DefaultDateParser.Parse("2022-12-12T11:01:02Z", "com")
+	assert.Nil(t, err)
+	assert.Equal(t, parsed.Layout, time.RFC3339)
+	assert.True(t, parsed.ZoneExplicit)
+
+	parsed, err = DefaultDateParser.Parse("03/02/2020", "tk")
+	assert.Nil(t, err)
+	assert.Equal(t, parsed.Layout, "01/02/2006")
+
+	parser := NewDateParser()
+	_, err = parser.Parse("2020-03-02 at 10h30", "xyz")
+	assert.NotNil(t, err)
+
+	parser.RegisterFormat("2006-01-02 at 15h04", "XYZ")
+	parsed, err = parser.Parse("2020-03-02 at 10h30", "xyz")
+	assert.Nil(t, err)
+	assert.Equal(t, parsed.Layout, "2006-01-02 at 15h04")
+	assert.Equal(t, parsed.Time.Format(time.RFC3339), "2020-03-02T10:30:00Z")
+	assert.False(t, parsed.ZoneExplicit)
+
+	_, err = parser.Parse("2020-03-02 at 10h30", "com")
+	assert.NotNil(t, err)
+	_, err = DefaultDateParser.Parse("2020-03-02 at 10h30", "xyz")
+	assert.NotNil(t, err)
+
+	parser.RegisterFormat("02.01.06")
+	parsed, err = parser.Parse("02.03.20", "com")
+	assert.Nil(t, err)
+	assert.Equal(t, parsed.Layout, "02.01.06")
+	assert.Equal(t, parsed.Time.Format("2006-01-02"), "2020-03-02")
+
+	parser.RegisterFormat("Jan/2006", "ripe")
+	parsed, err = parser.Parse("Aug/1996", "ripe")
+	assert.Nil(t, err)
+	assert.Equal(t, parsed.Layout, "Jan/2006")
+	assert.Equal(t, parsed.Time.Month(), time.August)
+	assert.Equal(t, parsed.Time.Year(), 1996)
+	assert.True(t, parsed.Approximate)
+}
@@ -50,6 +50,16 @@ var (
 	ErrASDataInvalid = errors.New("whoisparser: AS whois data is invalid")
 	// ErrASLimitExceed AS whois query is limited
 	ErrASLimitExceed = errors.New("whoisparser: AS whois query limit exceeded")
+	// ErrNotFoundOrg organization is not found
+	ErrNotFoundOrg = errors.New("whoisparser: organization is not found")
+	// ErrOrgDataInvalid organization whois data is invalid
+	ErrOrgDataInvalid = errors.New("whoisparser: organization whois data is invalid")
+	// ErrNotFoundPOC point of contact is not found
+	ErrNotFoundPOC = errors.New("whoisparser: point of contact is not found")
+	// ErrPOCDataInvalid point of contact whois data is invalid
+	ErrPOCDataInvalid = errors.New("whoisparser: point of contact whois data is invalid")
+	// ErrQueryMismatch whois data is about another object than the query
+	ErrQueryMismatch = errors.New("whoisparser: whois data does not match the query")
 )
 
 // getErrorType returns error type of whois data
@@ -104,6 +114,24 @@ func getASErrorType(data string) error {
 	}
 }
 
+// getOrgErrorType returns error type of organization data
+func getOrgErrorType(data string) error {
+	if isNotFoundIP(data) {
+		return ErrNotFoundOrg
+	}
+
+	return ErrOrgDataInvalid
+}
+
+// getPOCErrorType returns error type of point of contact data
+func getPOCErrorType(data string) error {
+	if isNotFoundIP(data) {
+		return ErrNotFoundPOC
+	}
+
+	return ErrPOCDataInvalid
+}
+
 // isNotFoundDomain returns if domain is not found
 func isNotFoundDomain(data string) bool {
 	notFoundKeys := []string{
@@ -1,7 +1,10 @@
 package whoisparser
 
 import (
+	"net/netip"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -250,3 +253,639 @@ OrgTechRef:    https://rdap.arin.net/registry/entity/TECH1-ARIN
 		})
 	}
 }
+
+// TestParseForIP tests that ParseFor detects IP WHOIS responses about other networks.
+func TestParseForIP(t *testing.T) {
+	input := `
+NetRange:       99.10.64.0 - 99.75.191.255
+CIDR:           99.74.0.0/16, 99.75.0.0/17
+NetName:        SBCIS-SBIS
+NetHandle:      NET-99-10-64-0-1
+`
+	result, err := ParseFor("99.10.64.1", input)
+	assert.Nil(t, err)
+	assert.Equal(t, "SBCIS-SBIS", result.IP.Networks[0].Name)
+
+	_, err = ParseFor("99.74.0.0/16", input)
+	assert.Nil(t, err)
+
+	result, err = ParseFor("8.8.8.8", input)
+	assert.Equal(t, ErrQueryMismatch, err)
+	assert.Equal(t, "SBCIS-SBIS", result.IP.Networks[0].Name)
+
+	_, err = ParseFor("2001:db8::1", input)
+	assert.Equal(t, ErrQueryMismatch, err)
+}
+
+// TestParseIPWhoisDateTimes tests that IP WHOIS dates are parsed into time values.
+func TestParseIPWhoisDateTimes(t *testing.T) {
+	input := `
+NetRange:       192.0.2.0 - 192.0.2.255
+NetName:        TEST-NET-1
+RegDate:        2020-01-01
+Updated:        2023-01-02
+
+OrgName:        Example Corp.
+OrgId:          EX-1234
+RegDate:        2019-05-06
+Updated:        2023-01-01
+Ref:            https://rdap.arin.net/registry/entity/EX-1234
+`
+	result, err := ParseIPWhois(input)
+	assert.Nil(t, err)
+	network := result.IP.Networks[0]
+	assert.Equal(t, "2020-01-01T00:00:00Z", network.RegDateInTime.Format(time.RFC3339))
+	assert.Equal(t, "2023-01-02T00:00:00Z", network.UpdatedInTime.Format(time.RFC3339))
+	assert.Equal(t, "2019-05-06T00:00:00Z", network.Organization.RegistrationDateInTime.Format(time.RFC3339))
+	assert.Equal(t, "2023-01-01T00:00:00Z", network.Organization.UpdatedInTime.Format(time.RFC3339))
+	assert.True(t, network.RegDateZoneAssumed)
+	assert.True(t, network.UpdatedZoneAssumed)
+	assert.True(t, network.Organization.RegistrationDateZoneAssumed)
+
+	input = `
+% This is the RIPE Database query service.
+
+inetnum:        193.0.0.0 - 193.0.7.255
+netname:        RIPE-NCC
+created:        2003-03-17T12:15:57Z
+last-modified:  2017-12-04T14:46:02Z
+source:         RIPE
+
+role:           RIPE NCC Operations
+created:        2002-09-16T10:35:22Z
+last-modified:  2022-04-08T08:09:04Z
+source:         RIPE
+`
+	result, err = ParseIPWhois(input)
+	assert.Nil(t, err)
+	network = result.IP.Networks[0]
+	assert.Equal(t, "2003-03-17T12:15:57Z", network.RegDateInTime.Format(time.RFC3339))
+	assert.Equal(t, "2017-12-04T14:46:02Z", network.UpdatedInTime.Format(time.RFC3339))
+	assert.False(t, network.RegDateZoneAssumed)
+	assert.False(t, network.UpdatedZoneAssumed)
+	assert.False(t, network.RegDateApproximate)
+
+	input = `
+NetRange:       198.51.100.0 - 198.51.100.255
+NetName:        TEST-NET-2
+RegDate:        before Aug-1996
+Updated:        2023-01-02
+`
+	result, err = ParseIPWhois(input)
+	assert.Nil(t, err)
+	network = result.IP.Networks[0]
+	assert.Equal(t, "1996-08-01T00:00:00Z", network.RegDateInTime.Format(time.RFC3339))
+	assert.True(t, network.RegDateApproximate)
+	assert.False(t, network.UpdatedApproximate)
+}
+
+const ripeIPWhois = `
+% This is the RIPE Database query service.
+% The objects are in RPSL format.
+%
+% The RIPE Database is subject to Terms and Conditions.
+% See https://apps.db.ripe.net/docs/HTML-Terms-And-Conditions
+
+% Note: this output has been filtered.
+%       To receive output for a database update, use the "-B" flag.
+
+% Information related to '193.0.0.0 - 193.0.7.255'
+
+% Abuse contact for '193.0.0.0 - 193.0.7.255' is 'abuse@ripe.net'
+
+inetnum:        193.0.0.0 - 193.0.7.255
+netname:        RIPE-NCC
+descr:          RIPE Network Coordination Centre
+descr:          Amsterdam, Netherlands
+remarks:        Used for RIPE NCC infrastructure.
+country:        NL
+admin-c:        BRD-RIPE
+tech-c:         OPS4-RIPE
+org:            ORG-RIEN1-RIPE
+status:         ASSIGNED PA
+mnt-by:         RIPE-NCC-MNT
+mnt-by:         RIPE-NCC-HM-MNT
+created:        2003-03-17T12:15:57Z
+last-modified:  2017-12-04T14:46:02Z
+source:         RIPE
+
+organisation:   ORG-RIEN1-RIPE
+org-name:       Reseaux IP Europeens Network Coordination Centre (RIPE NCC)
+org-type:       LIR
+address:        P.O. Box 10096
+address:        1001 EB
+address:        Amsterdam
+address:        NETHERLANDS
+phone:          +31205354444
+fax-no:         +31205354445
+admin-c:        BRD-RIPE
+abuse-c:        ops4-ripe
+mnt-ref:        RIPE-NCC-HM-MNT
+mnt-by:         RIPE-NCC-HM-MNT
+created:        2012-03-09T13:20:31Z
+last-modified:  2020-12-16T13:09:26Z
+source:         RIPE
+
+role:           RIPE NCC Operations
+address:        Stationsplein 11
+address:        1012 AB Amsterdam
+address:        The Netherlands
+phone:          +31 20 535 4444
+abuse-mailbox:  abuse@ripe.net
+admin-c:        BRD-RIPE
+tech-c:         GL7321-RIPE
+nic-hdl:        OPS4-RIPE
+mnt-by:         RIPE-NCC-MNT
+created:        2002-09-16T10:35:22Z
+last-modified:  2022-04-08T08:09:04Z
+source:         RIPE # Filtered
+
+person:         Brian Riddle
+address:        RIPE Network Coordination Centre
+address:        P.O. Box 10096
+address:        1001 EB Amsterdam
+address:        the Netherlands
+phone:          +31 20 535 4444
+nic-hdl:        BRD-RIPE
+mnt-by:         RIPE-NCC-LOCKED-MNT
+created:        1970-01-01T00:00:00Z
+last-modified:  2017-10-30T21:46:56Z
+source:         RIPE # Filtered
+
+% This query was served by the RIPE Database Query Service version 1.112 (SHETLAND)
+`
+
+const apnicIPWhois = `
+% [whois.apnic.net]
+% Whois data copyright terms    http://www.apnic.net/db/dbcopyright.html
+
+% Information related to '1.1.1.0 - 1.1.1.255'
+
+% Abuse contact for '1.1.1.0 - 1.1.1.255' is 'helpdesk@apnic.net'
+
+inetnum:        1.1.1.0 - 1.1.1.255
+netname:        APNIC-LABS
+descr:          APNIC and Cloudflare DNS Resolver project
+descr:          Routed globally by AS13335/Cloudflare
+descr:          Research prefix for APNIC Labs
+country:        AU
+org:            ORG-ARAD1-AP
+admin-c:        AIC3-AP
+tech-c:         AIC3-AP
+abuse-c:        AA1412-AP
+status:         ASSIGNED PORTABLE
+remarks:        ---------------
+remarks:        All Cloudflare abuse reporting can be done via
+remarks:        resolver-abuse@cloudflare.com
+remarks:        ---------------
+mnt-by:         APNIC-HM
+mnt-routes:     MAINT-APNICRANDNET
+mnt-irt:        IRT-APNICRANDNET-AU
+last-modified:  2023-04-26T22:57:58Z
+source:         APNIC
+
+irt:            IRT-APNICRANDNET-AU
+address:        PO Box 3646
+address:        South Brisbane, QLD 4101
+address:        Australia
+e-mail:         helpdesk@apnic.net
+abuse-mailbox:  helpdesk@apnic.net
+admin-c:        AR302-AP
+tech-c:         AR302-AP
+auth:           # Filtered
+remarks:        helpdesk@apnic.net was validated on 2021-02-09
+mnt-by:         MAINT-AU-APNIC-GM85-AP
+last-modified:  2021-03-09T01:10:21Z
+source:         APNIC
+
+organisation:   ORG-ARAD1-AP
+org-name:       APNIC Research and Development
+country:        AU
+address:        6 Cordelia St
+phone:          +61-7-38583100
+fax-no:         +61-7-38583199
+e-mail:         helpdesk@apnic.net
+mnt-ref:        APNIC-HM
+mnt-by:         APNIC-HM
+last-modified:  2023-09-05T02:15:19Z
+source:         APNIC
+
+role:           APNIC RESEARCH
+address:        PO Box 3646
+address:        South Brisbane, QLD 4101
+address:        Australia
+country:        AU
+phone:          +61-7-3858-3188
+fax-no:         +61-7-3858-3199
+e-mail:         research@apnic.net
+nic-hdl:        AIC3-AP
+tech-c:         AH256-AP
+admin-c:        AH256-AP
+mnt-by:         MAINT-APNIC-AP
+last-modified:  2023-04-26T22:50:54Z
+source:         APNIC
+`
+
+// TestParseIPWhoisRPSL tests the ParseIPWhois function with RIPE and APNIC responses.
+func TestParseIPWhoisRPSL(t *testing.T) {
+	result, err := ParseIPWhois(ripeIPWhois)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.IP.Networks))
+
+	network := result.IP.Networks[0]
+	assert.Equal(t, "193.0.0.0 - 193.0.7.255", network.Range)
+	assert.Equal(t, "RIPE-NCC", network.Name)
+	assert.Equal(t, "RIPE Network Coordination Centre\nAmsterdam, Netherlands", network.Description)
+	assert.Equal(t, "Used for RIPE NCC infrastructure.", network.Comment)
+	assert.Equal(t, "NL", network.Country)
+	assert.Equal(t, "ASSIGNED PA", network.Type)
+	assert.Equal(t, []string{"RIPE-NCC-MNT", "RIPE-NCC-HM-MNT"}, network.MntBy)
+	assert.Equal(t, "2003-03-17T12:15:57Z", network.RegDate)
+	assert.Equal(t, "2017-12-04T14:46:02Z", network.Updated)
+	assert.Equal(t, "RIPE", network.Source)
+	assert.Equal(t, "Reseaux IP Europeens Network Coordination Centre (RIPE NCC) (ORG-RIEN1-RIPE)", network.OrganizationName)
+
+	assert.NotNil(t, network.Organization)
+	assert.Equal(t, "ORG-RIEN1-RIPE", network.Organization.ID)
+	assert.Equal(t, "Reseaux IP Europeens Network Coordination Centre (RIPE NCC)", network.Organization.Organization)
+	assert.Equal(t, "P.O. Box 10096\n1001 EB\nAmsterdam\nNETHERLANDS", network.Organization.Street)
+	assert.Equal(t, "+31205354444", network.Organization.Phone)
+	assert.Equal(t, "+31205354445", network.Organization.Fax)
+	assert.Equal(t, "2012-03-09T13:20:31Z", network.Organization.RegistrationDate)
+
+	assert.Equal(t, 1, len(network.Administrative))
+	assert.Equal(t, "BRD-RIPE", network.Administrative[0].ID)
+	assert.Equal(t, "Brian Riddle", network.Administrative[0].Name)
+	assert.Equal(t, 1, len(network.Technical))
+	assert.Equal(t, "OPS4-RIPE", network.Technical[0].ID)
+	assert.Equal(t, "RIPE NCC Operations", network.Technical[0].Name)
+	assert.Equal(t, "+31 20 535 4444", network.Technical[0].Phone)
+	assert.NotNil(t, network.Abuse)
+	assert.Equal(t, "OPS4-RIPE", network.Abuse.ID)
+	assert.Equal(t, "abuse@ripe.net", network.Abuse.AbuseMailbox)
+	assert.Nil(t, result.IP.UnresolvedHandles)
+
+	result, err = ParseIPWhois(apnicIPWhois)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.IP.Networks))
+
+	network = result.IP.Networks[0]
+	assert.Equal(t, "1.1.1.0 - 1.1.1.255", network.Range)
+	assert.Equal(t, "APNIC-LABS", network.Name)
+	assert.Equal(t, "AU", network.Country)
+	assert.Equal(t, "ASSIGNED PORTABLE", network.Type)
+	assert.Equal(t, "APNIC", network.Source)
+	assert.Equal(t, "2023-04-26T22:57:58Z", network.UpdatedInTime.Format(time.RFC3339))
+	assert.Equal(t, "APNIC Research and Development", network.Organization.Organization)
+	assert.Equal(t, "helpdesk@apnic.net", network.Organization.Email)
+	assert.Equal(t, "AU", network.Organization.Country)
+	assert.Equal(t, "APNIC RESEARCH", network.Administrative[0].Name)
+	assert.Equal(t, "research@apnic.net", network.Technical[0].Email)
+	assert.Nil(t, network.Abuse)
+	assert.Equal(t, []string{"AA1412-AP"}, result.IP.UnresolvedHandles)
+
+	_, err = ParseIPWhois("% [whois.apnic.net]\n\n%ERROR:101: no entries found\n")
+	assert.Equal(t, ErrNotFoundIP, err)
+}
+
+// TestParseIPWhoisIPv6 tests that IPv6 networks of RPSL and ARIN formats are parsed in canonical form.
+func TestParseIPWhoisIPv6(t *testing.T) {
+	ripe := `% Information related to '2001:0678:0000::/32'
+
+inet6num:       2001:0678:0000::/32
+netname:        DE-DENIC-20021217
+country:        DE
+status:         ALLOCATED-BY-RIR
+mnt-by:         RIPE-NCC-HM-MNT
+created:        2002-12-17T12:49:39Z
+last-modified:  2020-11-17T13:44:56Z
+source:         RIPE
+`
+	result, err := ParseIPWhois(ripe)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.IP.Networks))
+	assert.Equal(t, "2001:678:: - 2001:678:ffff:ffff:ffff:ffff:ffff:ffff", result.IP.Networks[0].Range)
+	assert.Equal(t, []string{"2001:678::/32"}, result.IP.Networks[0].CIDR)
+	assert.Equal(t, "DE-DENIC-20021217", result.IP.Networks[0].Name)
+
+	arin := `
+NetRange:       2001:0500:0088:0000:0000:0000:0000:0000 - 2001:0500:0088:FFFF:FFFF:FFFF:FFFF:FFFF
+CIDR:           2001:0500:0088::/48
+NetName:        VERISIGN-IPV6-1
+NetHandle:      NET6-2001-500-88-1
+Parent:         NET6-2001-500-1 (NET6-2001-500-1)
+NetType:        Direct Assignment
+Organization:   VeriSign Infrastructure & Operations (VIO-2)
+RegDate:        2014-12-05
+Updated:        2014-12-05
+`
+	result, err = ParseIPWhois(arin)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.IP.Networks))
+	assert.Equal(t, "2001:500:88:: - 2001:500:88:ffff:ffff:ffff:ffff:ffff", result.IP.Networks[0].Range)
+	assert.Equal(t, []string{"2001:500:88::/48"}, result.IP.Networks[0].CIDR)
+
+	result, err = ParseFor("2001:500:88::1", arin)
+	assert.Nil(t, err)
+	assert.Equal(t, "VERISIGN-IPV6-1", result.IP.Networks[0].Name)
+
+	result, err = Parse(ripe)
+	assert.Nil(t, err)
+	assert.Equal(t, "DE-DENIC-20021217", result.IP.Networks[0].Name)
+}
+
+// TestParseIPWhoisPrefixes tests that typed ranges and covering prefixes are filled, and malformed ranges warned.
+func TestParseIPWhoisPrefixes(t *testing.T) {
+	input := `
+inetnum:        193.0.0.0 - 193.0.11.255
+netname:        EXAMPLE-NET
+source:         RIPE
+
+inetnum:        193.0.0.0 - 193.0.300.255
+netname:        BROKEN-NET
+source:         RIPE
+`
+	result, err := ParseIPWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(result.IP.Networks))
+
+	network := result.IP.Networks[0]
+	assert.Equal(t, netip.MustParseAddr("193.0.0.0"), network.Start)
+	assert.Equal(t, netip.MustParseAddr("193.0.11.255"), network.End)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("193.0.0.0/21"), netip.MustParsePrefix("193.0.8.0/22")}, network.Prefixes)
+	assert.Equal(t, []string{"193.0.0.0/21", "193.0.8.0/22"}, network.CIDR)
+
+	network = result.IP.Networks[1]
+	assert.False(t, network.Start.IsValid())
+	assert.Nil(t, network.Prefixes)
+	assert.Equal(t, "193.0.0.0 - 193.0.300.255", network.Range)
+	assert.Equal(t, []string{`malformed range "193.0.0.0 - 193.0.300.255" of network BROKEN-NET`}, result.IP.Warnings)
+}
+
+// TestFormatOrganizationName tests that the parenthesised ID is only added when both name and ID are present.
+func TestFormatOrganizationName(t *testing.T) {
+	assert.Equal(t, "Example Corp. (ORG-EX1-RIPE)", formatOrganizationName(&Contact{Organization: "Example Corp.", ID: "ORG-EX1-RIPE"}))
+	assert.Equal(t, "Example Corp.", formatOrganizationName(&Contact{Organization: "Example Corp."}))
+	assert.Equal(t, "ORG-EX1-RIPE", formatOrganizationName(&Contact{ID: "ORG-EX1-RIPE"}))
+	assert.Equal(t, "", formatOrganizationName(&Contact{}))
+}
+
+// TestParseIPWhoisLACNICAbbreviated tests LACNIC inetnum prefixes written with less than four octets.
+func TestParseIPWhoisLACNICAbbreviated(t *testing.T) {
+	input := `
+% LACNIC resource: whois.lacnic.net
+
+inetnum:     200.7.84/23
+status:      reallocated
+owner:       NIC Chile
+ownerid:     CL-NICH-LACNIC
+country:     CL
+created:     20010611
+`
+	result, err := ParseIPWhois(input)
+	assert.Nil(t, err)
+	network := result.IP.Networks[0]
+	assert.Equal(t, "200.7.84.0 - 200.7.85.255", network.Range)
+	assert.Equal(t, netip.MustParseAddr("200.7.84.0"), network.Start)
+	assert.Equal(t, netip.MustParseAddr("200.7.85.255"), network.End)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("200.7.84.0/23")}, network.Prefixes)
+	assert.Equal(t, []string{"200.7.84.0/23"}, network.CIDR)
+	assert.Empty(t, result.IP.Warnings)
+
+	result, err = ParseIPWhois(strings.Replace(input, "200.7.84/23", "200.7.300/23", 1))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`malformed range "200.7.300/23" of network 200.7.300/23`}, result.IP.Warnings)
+}
+
+// TestParseIPWhoisOrganizationName tests that a network owner without ID is named without parentheses.
+func TestParseIPWhoisOrganizationName(t *testing.T) {
+	input := `
+% LACNIC resource: whois.lacnic.net
+
+inetnum:     190.0.0.0/22
+status:      allocated
+owner:       ENTEL CHILE S.A.
+country:     CL
+created:     20060301
+`
+	result, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, "ENTEL CHILE S.A.", result.IP.Networks[0].OrganizationName)
+}
+
+// TestParseIPWhoisLACNIC tests parsing of LACNIC and registro.br IP WHOIS information.
+func TestParseIPWhoisLACNIC(t *testing.T) {
+	lacnic := `
+% Joint Whois - whois.lacnic.net
+%  This server accepts single ASN, IPv4 or IPv6 queries
+
+% LACNIC resource: whois.lacnic.net
+
+inetnum:     190.0.0.0/22
+status:      allocated
+aut-num:     AS27651
+owner:       ENTEL CHILE S.A.
+ownerid:     CL-ETCS-LACNIC
+responsible: Jose Rodriguez
+address:     Costanera Sur, 2760, Piso 21
+address:     7550000 - Santiago - RM
+country:     CL
+phone:       +56 2 23602911 []
+owner-c:     JRR
+tech-c:      JRR
+abuse-c:     JRR
+inetrev:     190.0.0.0/22
+nserver:     NS1.ENTEL.CL
+nsstat:      20241015 AA
+nslastaa:    20241015
+created:     20060301
+changed:     20190319
+
+nic-hdl:     JRR
+person:      Jose Rodriguez
+e-mail:      ipadmin@entel.cl
+address:     Costanera Sur, 2760, Piso 21
+country:     CL
+phone:       +56 2 23602911 []
+created:     20030326
+changed:     20220929
+`
+	result, err := Parse(lacnic)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.IP.Networks))
+
+	network := result.IP.Networks[0]
+	assert.Equal(t, "190.0.0.0 - 190.0.3.255", network.Range)
+	assert.Equal(t, []string{"190.0.0.0/22"}, network.CIDR)
+	assert.Equal(t, AllocationStatusAllocated, network.Status)
+	assert.Equal(t, "AS27651", network.OriginAS)
+	assert.Equal(t, "ENTEL CHILE S.A. (CL-ETCS-LACNIC)", network.OrganizationName)
+	assert.Equal(t, "Costanera Sur, 2760, Piso 21\n7550000 - Santiago - RM", network.Organization.Street)
+	assert.Equal(t, "CL", network.Country)
+	assert.Equal(t, "Jose Rodriguez", network.Administrative[0].Name)
+	assert.Equal(t, "ipadmin@entel.cl", network.Technical[0].Email)
+	assert.Equal(t, "JRR", network.Abuse.ID)
+	assert.Equal(t, "2006-03-01T00:00:00Z", network.RegDateInTime.Format(time.RFC3339))
+	assert.Equal(t, "2019-03-19T00:00:00Z", network.UpdatedInTime.Format(time.RFC3339))
+
+	email, source := result.IP.AbuseContact()
+	assert.Equal(t, "ipadmin@entel.cl", email)
+	assert.Equal(t, AbuseSourceEmail, source)
+
+	registroBR := `
+% Copyright (c) Nic.br
+%  The use of the data below is only permitted as described in
+%  full by the terms of use at https://registro.br/termo/en.html ,
+%  being prohibited its distribution, commercialization or
+%  reproduction, in particular, to use it for advertising or
+%  any similar purpose.
+
+inetnum:     200.160.0.0/20
+aut-num:     N/A
+abuse-c:     CEGSU
+owner:       Nucleo de Inf. e Coord. do Ponto BR - NIC.BR
+ownerid:     05.506.560/0001-36
+responsible: Demi Getschko
+owner-c:     DMG
+tech-c:      CEGSU
+inetrev:     200.160.0.0/20
+nserver:     a.dns.br
+created:     19980101
+changed:     20190404
+
+nic-hdl-br:  CEGSU
+person:      Centro de Estudos e Pesquisas
+e-mail:      cert@cert.br
+created:     20000101
+changed:     20200917
+`
+	result, err = Parse(registroBR)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.IP.Networks))
+
+	network = result.IP.Networks[0]
+	assert.Equal(t, "", network.OriginAS)
+	assert.Equal(t, "Nucleo de Inf. e Coord. do Ponto BR - NIC.BR", network.Organization.Organization)
+	assert.Equal(t, "05.506.560/0001-36", network.Organization.ID)
+	assert.Equal(t, "Demi Getschko", network.Organization.Name)
+	assert.Equal(t, "Centro de Estudos e Pesquisas", network.Technical[0].Name)
+	assert.Equal(t, "cert@cert.br", network.Abuse.Email)
+	assert.Nil(t, network.Administrative)
+	assert.Equal(t, []string{"DMG"}, result.IP.UnresolvedHandles)
+	assert.Equal(t, "1998-01-01T00:00:00Z", network.RegDateInTime.Format(time.RFC3339))
+}
+
+const afrinicIPWhois = `
+% This is the AfriNIC Whois server.
+% The AFRINIC whois database is subject to  the following terms of Use. See https://afrinic.net/whois/terms
+
+% Note: this output has been filtered.
+%       To receive output for a database update, use the "-B" flag.
+
+% Information related to '196.216.2.0 - 196.216.3.255'
+
+% No abuse contact registered for 196.216.2.0 - 196.216.3.255
+
+inetnum:        196.216.2.0 - 196.216.3.255
+netname:        AFRINIC-Ops-Services
+descr:          AFRINIC Operations Services
+country:        MU
+org:            ORG-AFNC1-AFRINIC
+admin-c:        AIC1-AFRINIC
+tech-c:         AIC1-AFRINIC
+status:         ASSIGNED PI
+mnt-by:         AFRINIC-IT-MNT
+source:         AFRINIC # Filtered
+parent:         196.216.0.0 - 196.216.255.255
+
+organisation:   ORG-AFNC1-AFRINIC
+org-name:       African Network Information Center - (AFRINIC)
+org-type:       RIR
+country:        MU
+address:        11th Floor, Standard Chartered Tower
+address:        Cybercity
+address:        Ebene
+phone:          tel:+230-403-5100
+abuse-c:        AIC2-AFRINIC
+mnt-ref:        AFRINIC-HM-MNT
+mnt-by:         AFRINIC-HM-MNT
+source:         AFRINIC # Filtered
+
+role:           AFRINIC IT Contact
+address:        11th Floor, Standard Chartered Tower
+address:        Cybercity, Ebene
+country:        MU
+phone:          tel:+230-403-5100
+e-mail:         it@afrinic.net
+nic-hdl:        AIC1-AFRINIC
+mnt-by:         AFRINIC-IT-MNT
+source:         AFRINIC # Filtered
+
+role:           AFRINIC Abuse Contact
+address:        11th Floor, Standard Chartered Tower
+country:        MU
+phone:          tel:+230-403-5100
+e-mail:         abuse@afrinic.net
+abuse-mailbox:  abuse@afrinic.net
+nic-hdl:        AIC2-AFRINIC
+mnt-by:         AFRINIC-HM-MNT
+source:         AFRINIC # Filtered
+
+% Information related to '196.216.2.0/23AS33764'
+
+route:          196.216.2.0/23
+descr:          AFRINIC Operations Services
+origin:         AS33764
+mnt-by:         AFRINIC-IT-MNT
+source:         AFRINIC # Filtered
+`
+
+// TestParseIPWhoisAFRINIC tests parsing of AFRINIC IP WHOIS information.
+func TestParseIPWhoisAFRINIC(t *testing.T) {
+	result, err := Parse(afrinicIPWhois)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.IP.Networks))
+
+	network := result.IP.Networks[0]
+	assert.Equal(t, "196.216.2.0 - 196.216.3.255", network.Range)
+	assert.Equal(t, []string{"196.216.2.0/23"}, network.CIDR)
+	assert.Equal(t, "AFRINIC-Ops-Services", network.Name)
+	assert.Equal(t, "196.216.0.0 - 196.216.255.255", network.Parent)
+	assert.Equal(t, AllocationStatusAssigned, network.Status)
+	assert.True(t, *network.Portable)
+	assert.Equal(t, "AFRINIC", network.Source)
+	assert.Equal(t, "African Network Information Center - (AFRINIC) (ORG-AFNC1-AFRINIC)", network.OrganizationName)
+	assert.Equal(t, "11th Floor, Standard Chartered Tower\nCybercity\nEbene", network.Organization.Street)
+	assert.Equal(t, "AFRINIC IT Contact", network.Administrative[0].Name)
+	assert.Equal(t, "it@afrinic.net", network.Technical[0].Email)
+	assert.Equal(t, "AIC2-AFRINIC", network.Abuse.ID)
+	assert.Nil(t, result.IP.UnresolvedHandles)
+
+	assert.Equal(t, 1, len(result.IP.Routes))
+	assert.Equal(t, "AS33764", result.IP.Routes[0].Origin)
+	assert.Equal(t, "AFRINIC", result.IP.Routes[0].Source)
+
+	email, source := result.IP.AbuseContact()
+	assert.Equal(t, "abuse@afrinic.net", email)
+	assert.Equal(t, AbuseSourceAbuseC, source)
+
+	result, err = ParseFor("196.216.2.1", afrinicIPWhois)
+	assert.Nil(t, err)
+	assert.Equal(t, "AFRINIC-Ops-Services", result.IP.Networks[0].Name)
+
+	result, err = ParseIPWhois(`
+% This is the AfriNIC Whois server.
+
+inet6num:       2001:43F8:0120:0000:0000:0000:0000:0000/48
+netname:        AFRINIC-IPv6-Services
+descr:          AFRINIC IPv6 Services
+country:        MU
+status:         ASSIGNED PI
+parent:         2001:4200::/23
+source:         AFRINIC # Filtered
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, "2001:43f8:120:: - 2001:43f8:120:ffff:ffff:ffff:ffff:ffff", result.IP.Networks[0].Range)
+	assert.Equal(t, []string{"2001:43f8:120::/48"}, result.IP.Networks[0].CIDR)
+	assert.Equal(t, "2001:4200::/23", result.IP.Networks[0].Parent)
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// arinMatchRx matches a network line of ARIN summary list, such as
+// "Level 3 Parent, LLC LVLT-ORG-4-8 (NET-4-0-0-0-1) 4.0.0.0 - 4.255.255.255"
+var arinMatchRx = regexp.MustCompile(`^(.+)\s+(\S+)\s+\((NET6?-[A-Za-z0-9-]+)\)\s+(\S+\s*-\s*\S+)$`)
+
+// isARINSummaryWhois returns if the whois information is an ARIN summary list of networks
+func isARINSummaryWhois(text string) bool {
+	return len(parseARINMatches(text)) > 0
+}
+
+// parseARINMatches returns the networks of ARIN summary list, returned for queries matching multiple records,
+// the full records are only returned on queries of their handle
+func parseARINMatches(text string) []*Match {
+	var matches []*Match
+
+	for _, line := range strings.Split(text, "\n") {
+		m := arinMatchRx.FindStringSubmatch(strings.TrimSpace(line))
+		if len(m) == 0 {
+			continue
+		}
+
+		match := &Match{
+			Handle:       m[3],
+			Name:         m[2],
+			Organization: strings.TrimSpace(m[1]),
+			Range:        m[4],
+		}
+
+		if start, end, ok := parseIPRange(match.Range); ok {
+			match.Range = start.String() + " - " + end.String()
+			match.Start = start
+			match.End = end
+		}
+
+		matches = append(matches, match)
+	}
+
+	return matches
+}
@@ -0,0 +1,72 @@
+package whoisparser
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const arinSummaryIPWhois = `
+#
+# ARIN WHOIS data and services are subject to the Terms of Use
+# available at: https://www.arin.net/resources/registry/whois/tou/
+#
+
+Level 3 Parent, LLC LVLT-ORG-4-8 (NET-4-0-0-0-1) 4.0.0.0 - 4.255.255.255
+Level 3 Parent, LLC LVLT-GIGE-4-68-112 (NET-4-68-112-0-1) 4.68.112.0 - 4.68.127.255
+Google LLC GOOGLE-IPV6 (NET6-2001-4860-1) 2001:4860:: - 2001:4860:FFFF:FFFF:FFFF:FFFF:FFFF:FFFF
+
+#
+# ARIN WHOIS data and services are subject to the Terms of Use
+# available at: https://www.arin.net/resources/registry/whois/tou/
+#
+`
+
+// TestParseARINMatches tests that ARIN summary lists are parsed into matches.
+func TestParseARINMatches(t *testing.T) {
+	assert.True(t, isIPWhois(arinSummaryIPWhois))
+
+	result, err := Parse(arinSummaryIPWhois)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(result.IP.Networks))
+	assert.Equal(t, 3, len(result.IP.Matches))
+
+	match := result.IP.Matches[0]
+	assert.Equal(t, "NET-4-0-0-0-1", match.Handle)
+	assert.Equal(t, "LVLT-ORG-4-8", match.Name)
+	assert.Equal(t, "Level 3 Parent, LLC", match.Organization)
+	assert.Equal(t, "4.0.0.0 - 4.255.255.255", match.Range)
+	assert.Equal(t, netip.MustParseAddr("4.0.0.0"), match.Start)
+	assert.Equal(t, netip.MustParseAddr("4.255.255.255"), match.End)
+
+	match = result.IP.Matches[2]
+	assert.Equal(t, "NET6-2001-4860-1", match.Handle)
+	assert.Equal(t, "GOOGLE-IPV6", match.Name)
+	assert.Equal(t, "Google LLC", match.Organization)
+	assert.Equal(t, "2001:4860:: - 2001:4860:ffff:ffff:ffff:ffff:ffff:ffff", match.Range)
+}
+
+// TestParseARINMatchesWithRecord tests that the summary list is kept along with the full record.
+func TestParseARINMatchesWithRecord(t *testing.T) {
+	input := `
+Level 3 Parent, LLC LVLT-ORG-4-8 (NET-4-0-0-0-1) 4.0.0.0 - 4.255.255.255
+Level 3 Parent, LLC LVLT-GIGE-4-68-112 (NET-4-68-112-0-1) 4.68.112.0 - 4.68.127.255
+
+NetRange:       4.68.112.0 - 4.68.127.255
+CIDR:           4.68.112.0/20
+NetName:        LVLT-GIGE-4-68-112
+NetHandle:      NET-4-68-112-0-1
+Parent:         LVLT-ORG-4-8 (NET-4-0-0-0-1)
+NetType:        Reallocated
+`
+	result, err := ParseIPWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.IP.Networks))
+	assert.Equal(t, "NET-4-68-112-0-1", result.IP.Networks[0].Handle)
+	assert.Equal(t, 2, len(result.IP.Matches))
+
+	result, err = ParseIPWhois(arinHierarchyIPWhois)
+	assert.Nil(t, err)
+	assert.Nil(t, result.IP.Matches)
+}
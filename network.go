@@ -0,0 +1,213 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/likexian/gokit/assert"
+)
+
+// AllocationStatus is the normalized allocation status of a network
+type AllocationStatus string
+
+const (
+	// AllocationStatusUnknown means the status is missing or not recognized
+	AllocationStatusUnknown AllocationStatus = ""
+	// AllocationStatusAllocated is a block allocated by the registry, such as "Direct Allocation" or "ALLOCATED PA"
+	AllocationStatusAllocated AllocationStatus = "allocated"
+	// AllocationStatusAssigned is a block assigned by the registry, such as "Direct Assignment" or "ASSIGNED PI"
+	AllocationStatusAssigned AllocationStatus = "assigned"
+	// AllocationStatusReallocated is a block allocated on by the holder, such as "Reallocated", "SUB-ALLOCATED PA" or "ALLOCATED-BY-LIR"
+	AllocationStatusReallocated AllocationStatus = "reallocated"
+	// AllocationStatusReassigned is a block assigned on by the holder, such as "Reassigned" or "ASSIGNED PA"
+	AllocationStatusReassigned AllocationStatus = "reassigned"
+	// AllocationStatusLegacy is a block registered before the registry, such as "LEGACY" or "Early Registrations"
+	AllocationStatusLegacy AllocationStatus = "legacy"
+)
+
+// parseAllocationStatus returns the normalized allocation status of the raw status and if the block is portable,
+// portable is nil if the status does not tell
+func parseAllocationStatus(value string) (status AllocationStatus, portable *bool) {
+	value = strings.ReplaceAll(strings.ToUpper(value), "NON-PORTABLE", "NONPORTABLE")
+	tokens := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_' || r == ','
+	})
+
+	has := func(vs ...string) bool {
+		for _, v := range vs {
+			if assert.IsContains(tokens, v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case has("LEGACY", "EARLY"):
+		status = AllocationStatusLegacy
+	case has("REALLOCATED", "PARTITIONED", "AGGREGATED"), has("SUB") && has("ALLOCATED"), has("ALLOCATED") && has("NONPORTABLE", "LIR"):
+		status = AllocationStatusReallocated
+	case has("REASSIGNED"), has("ASSIGNED") && has("PA", "NONPORTABLE"):
+		status = AllocationStatusReassigned
+	case has("ALLOCATED", "ALLOCATION"):
+		status = AllocationStatusAllocated
+	case has("ASSIGNED", "ASSIGNMENT"):
+		status = AllocationStatusAssigned
+	}
+
+	switch {
+	case has("NONPORTABLE", "PA"):
+		portable = new(bool)
+	case has("PORTABLE", "PI", "DIRECT"):
+		portable = new(bool)
+		*portable = true
+	case status == AllocationStatusReallocated || status == AllocationStatusReassigned:
+		portable = new(bool)
+	}
+
+	return
+}
+
+// NetworkNode is a network in the allocation hierarchy of IP whois information
+type NetworkNode struct {
+	Network  *Network       `json:"network"`
+	Children []*NetworkNode `json:"children,omitempty"`
+}
+
+// MostSpecific returns the smallest network containing the address, such as the customer
+// reassignment rather than the RIR allocation, or nil if no network contains it
+func (i *IPInfo) MostSpecific(addr netip.Addr) *Network {
+	var found *Network
+
+	addr = addr.Unmap()
+	for _, network := range i.Networks {
+		start, end, ok := networkBounds(network)
+		if !ok || start.BitLen() != addr.BitLen() || addr.Compare(start) < 0 || addr.Compare(end) > 0 {
+			continue
+		}
+		if found == nil || networkContains(found, network) {
+			found = network
+		}
+	}
+
+	return found
+}
+
+// Hierarchy returns the networks as trees from the top level allocations down to the reassignments,
+// a network is linked under the network named by its parent handle, or else under the smallest network containing it
+func (i *IPInfo) Hierarchy() []*NetworkNode {
+	nodes := make([]*NetworkNode, len(i.Networks))
+	for k, network := range i.Networks {
+		nodes[k] = &NetworkNode{Network: network}
+	}
+
+	parents := make([]int, len(i.Networks))
+	for k := range i.Networks {
+		parents[k] = i.searchParent(k)
+	}
+
+	// break the cycles made by inconsistent parent handles
+	for k := range parents {
+		for p, n := parents[k], 0; p >= 0 && n < len(parents); p, n = parents[p], n+1 {
+			if p == k {
+				parents[k] = -1
+				break
+			}
+		}
+	}
+
+	roots := []*NetworkNode{}
+	for k, node := range nodes {
+		if parents[k] < 0 {
+			roots = append(roots, node)
+		} else {
+			nodes[parents[k]].Children = append(nodes[parents[k]].Children, node)
+		}
+	}
+
+	return roots
+}
+
+// searchParent returns the index of the parent network of the network at index k, or -1 if none
+func (i *IPInfo) searchParent(k int) int {
+	network := i.Networks[k]
+
+	if handle := parentHandle(network.Parent); handle != "" {
+		for p, v := range i.Networks {
+			if p != k && strings.EqualFold(v.Handle, handle) {
+				return p
+			}
+		}
+	}
+
+	parent := -1
+	for p, v := range i.Networks {
+		if p == k || !networkContains(v, network) {
+			continue
+		}
+		// networks of the same range are ordered from the top level down
+		if networkContains(network, v) && p > k {
+			continue
+		}
+		if parent < 0 || networkContains(i.Networks[parent], v) {
+			parent = p
+		}
+	}
+
+	return parent
+}
+
+// parentHandle returns the handle of the parent network, written as "NET99 (NET-99-0-0-0-0)" by ARIN
+func parentHandle(parent string) string {
+	parent = strings.TrimSpace(parent)
+	start := strings.LastIndex(parent, "(")
+	end := strings.LastIndex(parent, ")")
+	if start >= 0 && end > start {
+		return strings.TrimSpace(parent[start+1 : end])
+	}
+
+	return parent
+}
+
+// networkContains returns if the network a contains the network b
+func networkContains(a, b *Network) bool {
+	aStart, aEnd, ok := networkBounds(a)
+	if !ok {
+		return false
+	}
+
+	bStart, bEnd, ok := networkBounds(b)
+	if !ok || aStart.BitLen() != bStart.BitLen() {
+		return false
+	}
+
+	return aStart.Compare(bStart) <= 0 && aEnd.Compare(bEnd) >= 0
+}
+
+// networkBounds returns the first and last address of the network
+func networkBounds(network *Network) (start, end netip.Addr, ok bool) {
+	if network.Start.IsValid() && network.End.IsValid() {
+		return network.Start, network.End, true
+	}
+
+	return parseIPRange(network.Range)
+}
@@ -0,0 +1,129 @@
+package whoisparser
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const arinHierarchyIPWhois = `
+NetRange:       99.0.0.0 - 99.255.255.255
+CIDR:           99.0.0.0/8
+NetName:        NET99
+NetHandle:      NET-99-0-0-0-0
+Parent:          ()
+NetType:        Allocated to ARIN
+
+NetRange:       99.10.64.0 - 99.75.191.255
+NetName:        SBCIS-SBIS
+NetHandle:      NET-99-10-64-0-1
+Parent:         NET99 (NET-99-0-0-0-0)
+NetType:        Direct Allocation
+
+NetRange:       99.74.0.0 - 99.74.0.255
+CIDR:           99.74.0.0/24
+NetName:        SBC-99-74-0-0-24
+NetHandle:      NET-99-74-0-0-1
+Parent:         SBCIS-SBIS (NET-99-10-64-0-1)
+NetType:        Reassigned
+
+NetRange:       99.74.0.0 - 99.74.0.63
+CIDR:           99.74.0.0/26
+NetName:        CUSTOMER-NET
+NetType:        Reassigned
+`
+
+// TestMostSpecific tests that the smallest network containing the address is returned.
+func TestMostSpecific(t *testing.T) {
+	result, err := ParseIPWhois(arinHierarchyIPWhois)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "CUSTOMER-NET", result.IP.MostSpecific(netip.MustParseAddr("99.74.0.1")).Name)
+	assert.Equal(t, "SBC-99-74-0-0-24", result.IP.MostSpecific(netip.MustParseAddr("99.74.0.64")).Name)
+	assert.Equal(t, "SBCIS-SBIS", result.IP.MostSpecific(netip.MustParseAddr("99.11.0.1")).Name)
+	assert.Equal(t, "NET99", result.IP.MostSpecific(netip.MustParseAddr("::ffff:99.1.0.1")).Name)
+	assert.Nil(t, result.IP.MostSpecific(netip.MustParseAddr("98.0.0.1")))
+	assert.Nil(t, result.IP.MostSpecific(netip.MustParseAddr("2001:db8::1")))
+}
+
+// TestHierarchy tests that networks are linked by parent handle and containment.
+func TestHierarchy(t *testing.T) {
+	result, err := ParseIPWhois(arinHierarchyIPWhois)
+	assert.Nil(t, err)
+
+	roots := result.IP.Hierarchy()
+	assert.Equal(t, 1, len(roots))
+	assert.Equal(t, "NET99", roots[0].Network.Name)
+	assert.Equal(t, 1, len(roots[0].Children))
+
+	node := roots[0].Children[0]
+	assert.Equal(t, "SBCIS-SBIS", node.Network.Name)
+	assert.Equal(t, 1, len(node.Children))
+
+	node = node.Children[0]
+	assert.Equal(t, "SBC-99-74-0-0-24", node.Network.Name)
+	assert.Equal(t, 1, len(node.Children))
+	assert.Equal(t, "CUSTOMER-NET", node.Children[0].Network.Name)
+	assert.Nil(t, node.Children[0].Children)
+
+	ipInfo := &IPInfo{
+		Networks: []*Network{
+			{Range: "192.0.2.0 - 192.0.2.255", Handle: "A", Parent: "B"},
+			{Range: "192.0.2.0 - 192.0.2.255", Handle: "B", Parent: "A"},
+			{Range: "2001:db8::/32", Handle: "C"},
+		},
+	}
+	roots = ipInfo.Hierarchy()
+	assert.Equal(t, 2, len(roots))
+	assert.Equal(t, "A", roots[0].Network.Handle)
+	assert.Equal(t, "B", roots[0].Children[0].Network.Handle)
+	assert.Equal(t, "C", roots[1].Network.Handle)
+}
+
+// TestParseAllocationStatus tests that raw statuses of the registries are normalized.
+func TestParseAllocationStatus(t *testing.T) {
+	portable, nonPortable := true, false
+	tests := []struct {
+		value    string
+		status   AllocationStatus
+		portable *bool
+	}{
+		{"Allocated to ARIN", AllocationStatusAllocated, nil},
+		{"Direct Allocation", AllocationStatusAllocated, &portable},
+		{"Direct Assignment", AllocationStatusAssigned, &portable},
+		{"Reallocated", AllocationStatusReallocated, &nonPortable},
+		{"Reassigned", AllocationStatusReassigned, &nonPortable},
+		{"Early Registrations, Maintained by ARIN", AllocationStatusLegacy, nil},
+		{"ALLOCATED PA", AllocationStatusAllocated, &nonPortable},
+		{"ASSIGNED PI", AllocationStatusAssigned, &portable},
+		{"ASSIGNED PA", AllocationStatusReassigned, &nonPortable},
+		{"SUB-ALLOCATED PA", AllocationStatusReallocated, &nonPortable},
+		{"LEGACY", AllocationStatusLegacy, nil},
+		{"ALLOCATED-BY-RIR", AllocationStatusAllocated, nil},
+		{"ALLOCATED-BY-LIR", AllocationStatusReallocated, &nonPortable},
+		{"ASSIGNED ANYCAST", AllocationStatusAssigned, nil},
+		{"AGGREGATED-BY-LIR", AllocationStatusReallocated, &nonPortable},
+		{"ALLOCATED PORTABLE", AllocationStatusAllocated, &portable},
+		{"ALLOCATED NON-PORTABLE", AllocationStatusReallocated, &nonPortable},
+		{"ASSIGNED PORTABLE", AllocationStatusAssigned, &portable},
+		{"ASSIGNED NON-PORTABLE", AllocationStatusReassigned, &nonPortable},
+		{"reallocated", AllocationStatusReallocated, &nonPortable},
+		{"assigned", AllocationStatusAssigned, nil},
+		{"", AllocationStatusUnknown, nil},
+		{"IANA Special Use", AllocationStatusUnknown, nil},
+	}
+
+	for _, v := range tests {
+		status, portable := parseAllocationStatus(v.value)
+		assert.Equal(t, v.status, status, v.value)
+		assert.Equal(t, v.portable, portable, v.value)
+	}
+
+	result, err := ParseIPWhois(arinHierarchyIPWhois)
+	assert.Nil(t, err)
+	assert.Equal(t, "Direct Allocation", result.IP.Networks[1].Type)
+	assert.Equal(t, AllocationStatusAllocated, result.IP.Networks[1].Status)
+	assert.Equal(t, AllocationStatusReassigned, result.IP.Networks[3].Status)
+	assert.False(t, *result.IP.Networks[3].Portable)
+}
@@ -0,0 +1,373 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/likexian/gokit/assert"
+)
+
+var (
+	jpnicNetworkRx = regexp.MustCompile(`(?m)^a\.\s*\[(?:IPネットワークアドレス|ネットワーク番号|IP Network Address|Network Number)\]`)
+	jpnicKeyRx     = regexp.MustCompile(`^(?:[a-z]\.\s*)?\[([^\]]+)\]\s*(.*)$`)
+	krnicNetworkRx = regexp.MustCompile(`(?m)^\s*(?:IPv4 Address|IPv6 Address|IPv4주소|IPv6주소)\s*:`)
+	twnicNetworkRx = regexp.MustCompile(`(?m)^\s*Netblock:`)
+	twnicRecordRx  = regexp.MustCompile(`^Record (created|last updated) on (.+)$`)
+	krnicSectionRx = regexp.MustCompile(`^\[\s*(.+?)\s*\]$`)
+)
+
+// searchNIR returns the national internet registry of the IP whois information
+// which is not in RPSL format, such as "jpnic", "krnic" or "twnic", CNNIC uses RPSL
+func searchNIR(text string) string {
+	switch {
+	case jpnicNetworkRx.MatchString(text):
+		return "jpnic"
+	case krnicNetworkRx.MatchString(text):
+		return "krnic"
+	case twnicNetworkRx.MatchString(text):
+		return "twnic"
+	}
+
+	return ""
+}
+
+// parseNIRIPWhois parses IP whois information of the national internet registry
+func parseNIRIPWhois(nir, text string) *IPInfo {
+	switch nir {
+	case "jpnic":
+		return parseJPNICIPWhois(text)
+	case "krnic":
+		return parseKRNICIPWhois(text)
+	case "twnic":
+		return parseTWNICIPWhois(text)
+	}
+
+	return &IPInfo{Networks: []*Network{}}
+}
+
+// parseJPNICIPWhois parses IP whois information of JPNIC, with keys in brackets such as
+// "a. [ネットワーク番号]" in Japanese or "a. [Network Number]" in English
+func parseJPNICIPWhois(text string) *IPInfo { //nolint:cyclop
+	ipInfo := &IPInfo{
+		Networks: []*Network{},
+	}
+
+	var network *Network
+	var contact *Contact
+	contacts := map[string]*Contact{}
+
+	for _, line := range strings.Split(text, "\n") {
+		m := jpnicKeyRx.FindStringSubmatch(strings.TrimSpace(line))
+		if len(m) == 0 {
+			continue
+		}
+
+		key := m[1]
+		value := strings.TrimSpace(m[2])
+
+		switch key {
+		case "IPネットワークアドレス", "ネットワーク番号", "IP Network Address", "Network Number":
+			network = &Network{Range: value, Source: "JPNIC"}
+			ipInfo.Networks = append(ipInfo.Networks, network)
+			contact = nil
+			continue
+		case "JPNICハンドル", "JPNIC Handle":
+			contact = &Contact{ID: value}
+			contacts[strings.ToUpper(value)] = contact
+			continue
+		}
+
+		if contact != nil {
+			switch key {
+			case "氏名", "Last, First":
+				contact.Name = value
+			case "電子メイル", "E-Mail":
+				contact.Email = strings.ToLower(value)
+			case "組織名", "Organization":
+				if contact.Organization == "" || key == "Organization" {
+					contact.Organization = value
+				}
+			case "電話番号", "TEL":
+				contact.Phone = value
+			case "FAX番号", "FAX":
+				contact.Fax = value
+			case "最終更新", "Last Update":
+				contact.Updated = value
+			}
+			continue
+		}
+
+		if network == nil || value == "" {
+			continue
+		}
+
+		switch key {
+		case "ネットワーク名", "Network Name":
+			network.Name = value
+		case "組織名", "Organization":
+			// the English name is preferred when both are present
+			if network.Organization == nil || key == "Organization" {
+				network.Organization = &Contact{Organization: value}
+				network.OrganizationName = value
+			}
+		case "管理者連絡窓口", "Administrative Contact":
+			network.Administrative = append(network.Administrative, &Contact{ID: value})
+		case "技術連絡担当者", "Technical Contact":
+			network.Technical = append(network.Technical, &Contact{ID: value})
+		case "ステータス", "Status":
+			network.Type = value
+		case "割当年月日", "割振年月日", "Assigned Date", "Allocated Date":
+			network.RegDate = value
+		case "最終更新", "Last Update":
+			network.Updated = value
+		}
+	}
+
+	// JPNIC contacts are referenced by handle, their information is only present on handle queries
+	for _, network := range ipInfo.Networks {
+		for _, vs := range [][]*Contact{network.Administrative, network.Technical} {
+			for i, v := range vs {
+				if c, ok := contacts[strings.ToUpper(v.ID)]; ok {
+					vs[i] = c
+				} else if !assert.IsContains(ipInfo.UnresolvedHandles, v.ID) {
+					ipInfo.UnresolvedHandles = append(ipInfo.UnresolvedHandles, v.ID)
+				}
+			}
+		}
+	}
+
+	return ipInfo
+}
+
+// parseKRNICIPWhois parses IP whois information of KRNIC, the English part is used if present,
+// contacts are split by their section such as "[ Admin Contact Information ]", and are technical if not in a contact section
+func parseKRNICIPWhois(text string) *IPInfo { //nolint:cyclop
+	ipInfo := &IPInfo{
+		Networks: []*Network{},
+	}
+
+	english := "# ENGLISH"
+	if pos := strings.Index(text, english); pos != -1 {
+		text = text[pos+len(english):]
+	}
+
+	var network *Network
+	var contact *Contact
+	section := ""
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if m := krnicSectionRx.FindStringSubmatch(line); len(m) > 0 {
+			section = strings.ToLower(m[1])
+			contact = nil
+			continue
+		}
+
+		if !strings.Contains(line, ":") {
+			continue
+		}
+
+		vs := strings.SplitN(line, ":", 2)
+		key := strings.TrimSpace(vs[0])
+		value := strings.TrimSpace(vs[1])
+
+		switch key {
+		case "IPv4 Address", "IPv6 Address", "IPv4주소", "IPv6주소":
+			// the prefix lengths are appended as "211.104.0.0 - 211.119.255.255 (/12,/13)"
+			if pos := strings.Index(value, "("); pos != -1 {
+				value = strings.TrimSpace(value[:pos])
+			}
+			network = &Network{Range: value, Source: "KRNIC", Organization: &Contact{}}
+			ipInfo.Networks = append(ipInfo.Networks, network)
+			contact = nil
+			continue
+		}
+
+		if network == nil || value == "" {
+			continue
+		}
+
+		switch key {
+		case "Organization Name", "기관명":
+			network.Organization.Organization = value
+			network.OrganizationName = value
+		case "Service Name", "서비스명":
+			network.Name = value
+		case "Network Type", "네트워크 구분":
+			network.Type = value
+		case "Address", "주소":
+			network.Organization.Street = value
+		case "Zip Code", "우편번호":
+			network.Organization.PostalCode = value
+		case "Registration Date", "할당일자", "할당내역 등록일":
+			network.RegDate = value
+		case "Name", "이름":
+			contact = &Contact{Name: value}
+			switch searchKRNICContactRole(section) {
+			case "abuse":
+				if network.Abuse == nil {
+					network.Abuse = contact
+				}
+			case "admin":
+				network.Administrative = append(network.Administrative, contact)
+			case "tech", "":
+				network.Technical = append(network.Technical, contact)
+			default:
+				contact = nil
+			}
+		case "Phone", "전화번호":
+			if contact != nil {
+				contact.Phone = value
+			}
+		case "E-Mail", "전자우편":
+			if contact != nil {
+				contact.Email = strings.ToLower(value)
+			}
+		}
+	}
+
+	return ipInfo
+}
+
+// searchKRNICContactRole returns the contact role of the KRNIC section, such as "abuse" of "[ Network Abuse Contact Information ]",
+// empty if the section is not of contacts, or "other" if the role is unknown
+func searchKRNICContactRole(section string) string {
+	switch {
+	case strings.Contains(section, "abuse") || strings.Contains(section, "어뷰즈"):
+		return "abuse"
+	case strings.Contains(section, "admin") || strings.Contains(section, "관리"):
+		return "admin"
+	case strings.Contains(section, "tech") || strings.Contains(section, "기술"):
+		return "tech"
+	case strings.Contains(section, "contact") || strings.Contains(section, "담당자"):
+		return "other"
+	}
+
+	return ""
+}
+
+// parseTWNICIPWhois parses IP whois information of TWNIC, contacts are indented lines under their section
+func parseTWNICIPWhois(text string) *IPInfo { //nolint:cyclop
+	ipInfo := &IPInfo{
+		Networks: []*Network{},
+	}
+
+	var network *Network
+	var contact *Contact
+	section := ""
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			contact = nil
+			section = ""
+			continue
+		}
+
+		if m := twnicRecordRx.FindStringSubmatch(line); len(m) > 0 {
+			if network != nil {
+				if m[1] == "created" {
+					network.RegDate = m[2]
+				} else {
+					network.Updated = m[2]
+				}
+			}
+			continue
+		}
+
+		if strings.HasSuffix(line, ":") {
+			section = strings.ToLower(strings.TrimSuffix(line, ":"))
+			contact = nil
+			continue
+		}
+
+		if section != "" {
+			if network == nil {
+				continue
+			}
+			switch section {
+			case "registrant":
+				if network.Organization == nil {
+					network.Organization = &Contact{Organization: line}
+					network.OrganizationName = line
+				} else {
+					network.Organization.Street = strings.TrimSpace(network.Organization.Street + "\n" + line)
+				}
+			case "administrator contact", "technical contact":
+				if contact == nil {
+					contact = parseTWNICContact(line)
+					if section == "technical contact" {
+						network.Technical = append(network.Technical, contact)
+					} else {
+						network.Administrative = append(network.Administrative, contact)
+					}
+				} else if strings.HasSuffix(strings.ToUpper(line), "(FAX)") {
+					contact.Fax = strings.TrimSpace(line[:len(line)-len("(FAX)")])
+				} else {
+					contact.Phone = line
+				}
+			}
+			continue
+		}
+
+		vs := strings.SplitN(line, ":", 2)
+		if len(vs) != 2 {
+			continue
+		}
+
+		key := strings.ToLower(strings.TrimSpace(vs[0]))
+		value := strings.TrimSpace(vs[1])
+
+		switch key {
+		case "netname", "netblock":
+			if network == nil || (key == "netname" && network.Name != "") || (key == "netblock" && network.Range != "") {
+				network = &Network{Source: "TWNIC"}
+				ipInfo.Networks = append(ipInfo.Networks, network)
+			}
+			if key == "netname" {
+				network.Name = value
+			} else {
+				network.Range = value
+			}
+		}
+	}
+
+	return ipInfo
+}
+
+// parseTWNICContact returns contact of the first line of TWNIC contact,
+// written as "Network Administrator (network-adm@hinet.net)" or as a single email
+func parseTWNICContact(line string) *Contact {
+	start := strings.LastIndex(line, "(")
+	if start != -1 && strings.HasSuffix(line, ")") {
+		return &Contact{
+			Name:  strings.TrimSpace(line[:start]),
+			Email: strings.ToLower(strings.TrimSpace(line[start+1 : len(line)-1])),
+		}
+	}
+
+	if strings.Contains(line, "@") {
+		return &Contact{Email: strings.ToLower(line)}
+	}
+
+	return &Contact{Name: line}
+}
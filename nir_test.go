@@ -0,0 +1,261 @@
+package whoisparser
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseIPWhoisJPNIC tests parsing of JPNIC IP WHOIS information in Japanese and English.
+func TestParseIPWhoisJPNIC(t *testing.T) {
+	japanese := `
+[ JPNIC database provides information regarding IP address and ASN. Its use   ]
+[ is restricted to network administration purposes. For further information,  ]
+[ use 'whois -h whois.nic.ad.jp help'. To only display English output,        ]
+[ add '/e' at the end of command, e.g. 'whois -h whois.nic.ad.jp xxx/e'.      ]
+
+Network Information: [ネットワーク情報]
+a. [IPネットワークアドレス]     192.41.192.0/24
+b. [ネットワーク名]             JPNIC-NET
+f. [組織名]                     一般社団法人　日本ネットワークインフォメーションセンター
+g. [Organization]               Japan Network Information Center
+m. [管理者連絡窓口]             JI13374JP
+n. [技術連絡担当者]             JI13374JP
+n. [技術連絡担当者]             YN5924JP
+p. [ネームサーバ]
+[割当年月日]                    1990/04/01
+[返却年月日]
+[最終更新]                      2023/07/04 17:28:56(JST)
+
+上位情報
+----------
+日本ネットワークインフォメーションセンター (JPNIC-NET-JP-ASSIGN)
+    [割り振り]                  192.41.192.0/24  (2016/12/22)
+
+Contact Information: [担当者情報]
+a. [JPNICハンドル]              JI13374JP
+c. [氏名]                       日本ネットワークインフォメーションセンター
+d. [電子メイル]                 hostmaster@nic.ad.jp
+g. [組織名]                     一般社団法人　日本ネットワークインフォメーションセンター
+g. [Organization]               Japan Network Information Center
+[電話番号]                      03-5297-2311
+[FAX番号]                       03-5297-2312
+[最終更新]                      2022/04/25 14:59:45(JST)
+`
+	result, err := Parse(japanese)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.IP.Networks))
+
+	network := result.IP.Networks[0]
+	assert.Equal(t, "192.41.192.0 - 192.41.192.255", network.Range)
+	assert.Equal(t, []string{"192.41.192.0/24"}, network.CIDR)
+	assert.Equal(t, "JPNIC-NET", network.Name)
+	assert.Equal(t, "Japan Network Information Center", network.OrganizationName)
+	assert.Equal(t, "JPNIC", network.Source)
+	assert.Equal(t, "hostmaster@nic.ad.jp", network.Administrative[0].Email)
+	assert.Equal(t, "03-5297-2311", network.Technical[0].Phone)
+	assert.Equal(t, "YN5924JP", network.Technical[1].ID)
+	assert.Equal(t, []string{"YN5924JP"}, result.IP.UnresolvedHandles)
+	assert.Equal(t, "1990-04-01T00:00:00+09:00", network.RegDateInTime.Format(time.RFC3339))
+	assert.Equal(t, "2023-07-04T17:28:56+09:00", network.UpdatedInTime.Format(time.RFC3339))
+	assert.True(t, network.RegDateZoneAssumed)
+	assert.False(t, network.UpdatedZoneAssumed)
+
+	english := `
+Network Information:
+a. [Network Number]             202.12.30.0/24
+b. [Network Name]               WIDE-NET
+g. [Organization]               WIDE Project
+m. [Administrative Contact]     JA2000JP
+n. [Technical Contact]          JA2000JP
+p. [Nameserver]
+[Assigned Date]                 1993/09/06
+[Return Date]
+[Last Update]                   2019/02/04 10:02:40(JST)
+`
+	result, err = Parse(english)
+	assert.Nil(t, err)
+	assert.Equal(t, "WIDE-NET", result.IP.Networks[0].Name)
+	assert.Equal(t, "WIDE Project", result.IP.Networks[0].Organization.Organization)
+	assert.Equal(t, "JA2000JP", result.IP.Networks[0].Administrative[0].ID)
+	assert.Equal(t, []string{"JA2000JP"}, result.IP.UnresolvedHandles)
+}
+
+// TestParseIPWhoisKRNIC tests parsing of KRNIC IP WHOIS information, preferring the English part.
+func TestParseIPWhoisKRNIC(t *testing.T) {
+	input := `
+query : 211.104.1.1
+
+# KOREAN(UTF8)
+
+조회하신 IPv4주소는 한국인터넷진흥원으로부터 아래의 관리대행자에게 할당되었으며, 할당 정보는 다음과 같습니다.
+
+[ 네트워크 할당 정보 ]
+IPv4주소           : 211.104.0.0 - 211.119.255.255 (/12,/13)
+기관명             : 주식회사 케이티
+서비스명           : KORNET
+주소               : 경기도 성남시 분당구 불정로 90
+우편번호           : 13606
+할당일자           : 19990201
+
+이름               : IP주소 담당자
+전화번호           : +82-2-500-6630
+전자우편           : kornet_ip@kt.com
+
+# ENGLISH
+
+KRNIC is not an ISP but a National Internet Registry similar to APNIC.
+
+[ Network Information ]
+IPv4 Address       : 211.104.0.0 - 211.119.255.255 (/12,/13)
+Organization Name  : Korea Telecom
+Service Name       : KORNET
+Address            : Gyeonggi-do Bundang-gu, Seongnam-si Buljeong-ro 90
+Zip Code           : 13606
+Registration Date  : 19990201
+
+Name               : IP Manager
+Phone              : +82-2-500-6630
+E-Mail             : kornet_ip@kt.com
+
+--------------------------------------------------------------------------------
+
+[ Network Information ]
+IPv4 Address       : 211.104.1.0 - 211.104.1.255 (/24)
+Organization Name  : Example Customer
+Network Type       : CUSTOMER
+Address            : Seoul
+Zip Code           : 06164
+Registration Date  : 20061214
+
+[ Admin Contact Information ]
+Name               : Network Admin
+Phone              : +82-2-555-0001
+E-Mail             : admin@example.co.kr
+
+[ Technical Contact Information ]
+Name               : IP Manager
+Phone              : +82-2-555-0000
+E-Mail             : ip@example.co.kr
+
+[ Network Abuse Contact Information ]
+Name               : Abuse Desk
+Phone              : +82-2-555-0002
+E-Mail             : abuse@example.co.kr
+`
+	result, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(result.IP.Networks))
+
+	network := result.IP.Networks[0]
+	assert.Equal(t, "211.104.0.0 - 211.119.255.255", network.Range)
+	assert.Equal(t, []string{"211.104.0.0/13", "211.112.0.0/13"}, network.CIDR)
+	assert.Equal(t, "KORNET", network.Name)
+	assert.Equal(t, "Korea Telecom", network.OrganizationName)
+	assert.Equal(t, "13606", network.Organization.PostalCode)
+	assert.Equal(t, "kornet_ip@kt.com", network.Technical[0].Email)
+	assert.Equal(t, "1999-02-01T00:00:00+09:00", network.RegDateInTime.Format(time.RFC3339))
+	assert.True(t, network.RegDateZoneAssumed)
+
+	network = result.IP.MostSpecific(netip.MustParseAddr("211.104.1.1"))
+	assert.Equal(t, "Example Customer", network.OrganizationName)
+	assert.Equal(t, "CUSTOMER", network.Type)
+	assert.Equal(t, 1, len(network.Administrative))
+	assert.Equal(t, "Network Admin", network.Administrative[0].Name)
+	assert.Equal(t, "admin@example.co.kr", network.Administrative[0].Email)
+	assert.Equal(t, 1, len(network.Technical))
+	assert.Equal(t, "IP Manager", network.Technical[0].Name)
+	assert.Equal(t, "+82-2-555-0000", network.Technical[0].Phone)
+	assert.Equal(t, "Abuse Desk", network.Abuse.Name)
+	assert.Equal(t, "abuse@example.co.kr", network.Abuse.Email)
+
+	result, err = ParseIPWhois(input[:strings.Index(input, "# ENGLISH")])
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.IP.Networks))
+	assert.Equal(t, "주식회사 케이티", result.IP.Networks[0].OrganizationName)
+	assert.Equal(t, "KORNET", result.IP.Networks[0].Name)
+	assert.Equal(t, "kornet_ip@kt.com", result.IP.Networks[0].Technical[0].Email)
+}
+
+// TestSearchKRNICContactRole tests the contact roles of KRNIC sections.
+func TestSearchKRNICContactRole(t *testing.T) {
+	assert.Equal(t, "abuse", searchKRNICContactRole("network abuse contact information"))
+	assert.Equal(t, "admin", searchKRNICContactRole("admin contact information"))
+	assert.Equal(t, "tech", searchKRNICContactRole("technical contact information"))
+	assert.Equal(t, "abuse", searchKRNICContactRole("네트워크 어뷰즈 담당자 정보"))
+	assert.Equal(t, "", searchKRNICContactRole("network information"))
+	assert.Equal(t, "other", searchKRNICContactRole("billing contact information"))
+}
+
+// TestParseIPWhoisTWNIC tests parsing of TWNIC IP WHOIS information.
+func TestParseIPWhoisTWNIC(t *testing.T) {
+	input := `
+   Netname: HINET-NET
+   Netblock: 168.95.0.0-168.95.255.255
+
+   Registrant:
+        Data Communication Business Group,Chunghwa Telecom Co.,Ltd.
+        No.21, Sec.1, Xinyi Rd.
+        Taipei City, Taiwan
+
+   Administrator contact:
+      Network Administrator (network-adm@hinet.net)
+      +886-2-2344-3007
+      +886-2-2344-4829 (FAX)
+
+   Technical contact:
+      network-adm@hinet.net
+
+   Record created on 1993-05-24 00:00:00 (UTC+8)
+   Record last updated on 2023-02-06 14:21:07 (UTC+8)
+`
+	result, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.IP.Networks))
+
+	network := result.IP.Networks[0]
+	assert.Equal(t, "HINET-NET", network.Name)
+	assert.Equal(t, "168.95.0.0 - 168.95.255.255", network.Range)
+	assert.Equal(t, []string{"168.95.0.0/16"}, network.CIDR)
+	assert.Equal(t, "Data Communication Business Group,Chunghwa Telecom Co.,Ltd.", network.OrganizationName)
+	assert.Equal(t, "No.21, Sec.1, Xinyi Rd.\nTaipei City, Taiwan", network.Organization.Street)
+	assert.Equal(t, "Network Administrator", network.Administrative[0].Name)
+	assert.Equal(t, "network-adm@hinet.net", network.Administrative[0].Email)
+	assert.Equal(t, "+886-2-2344-3007", network.Administrative[0].Phone)
+	assert.Equal(t, "+886-2-2344-4829", network.Administrative[0].Fax)
+	assert.Equal(t, "network-adm@hinet.net", network.Technical[0].Email)
+	assert.Equal(t, "1993-05-24T00:00:00+08:00", network.RegDateInTime.Format(time.RFC3339))
+	assert.Equal(t, "2023-02-06T14:21:07+08:00", network.UpdatedInTime.Format(time.RFC3339))
+}
+
+// TestParseIPWhoisCNNIC tests parsing of CNNIC IP WHOIS information, which is in RPSL format.
+func TestParseIPWhoisCNNIC(t *testing.T) {
+	input := `
+inetnum:        159.226.0.0 - 159.226.255.255
+netname:        CSTNET
+descr:          Computer Network Information Center, CAS
+country:        CN
+admin-c:        ZL3-CN
+tech-c:         ZL3-CN
+status:         ALLOCATED PORTABLE
+last-modified:  2021-06-16T08:28:01Z
+source:         CNNIC
+
+person:         Zhang Li
+address:        4 South 4th Street, Zhongguancun, Beijing
+e-mail:         ipas@cnnic.cn
+nic-hdl:        ZL3-CN
+source:         CNNIC
+`
+	result, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.IP.Networks))
+	assert.Equal(t, "CSTNET", result.IP.Networks[0].Name)
+	assert.Equal(t, "CNNIC", result.IP.Networks[0].Source)
+	assert.Equal(t, "ipas@cnnic.cn", result.IP.Networks[0].Technical[0].Email)
+	assert.Equal(t, AllocationStatusAllocated, result.IP.Networks[0].Status)
+	assert.Equal(t, "2021-06-16T08:28:01Z", result.IP.Networks[0].UpdatedInTime.Format(time.RFC3339))
+}
@@ -0,0 +1,194 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	arinOrgRx        = regexp.MustCompile(`(?im)^OrgId:\s*\S+`)
+	arinPOCRx        = regexp.MustCompile(`(?im)^(?:POC)?Handle:\s*\S+-ARIN\s*$`)
+	arinOrgContactRx = regexp.MustCompile(`^org(abuse|tech|noc|admin|routing|dns)(handle|name|phone|email|ref)$`)
+)
+
+// isARINOrgWhois returns if the whois information is a standalone ARIN organization record, as returned for "o + HANDLE"
+func isARINOrgWhois(text string) bool {
+	return arinOrgRx.MatchString(text) && !strings.Contains(text, "NetRange:") && !strings.Contains(text, "ASNumber:")
+}
+
+// isARINPOCWhois returns if the whois information is an ARIN point of contact record, as returned for "p + HANDLE"
+func isARINPOCWhois(text string) bool {
+	return arinPOCRx.MatchString(text) && !isARINOrgWhois(text)
+}
+
+// ParseOrgWhois parses ARIN organization whois information, with the contacts of the organization by role
+func ParseOrgWhois(text string) (whoisInfo WhoisInfo, err error) {
+	orgInfo := &OrgInfo{}
+	var contact *Contact
+
+	for _, line := range strings.Split(text, "\n") {
+		key, value, ok := splitARINLine(line)
+		if !ok {
+			continue
+		}
+
+		if m := arinOrgContactRx.FindStringSubmatch(key); len(m) > 0 {
+			if m[2] == "handle" {
+				contact = &Contact{ID: value}
+				switch m[1] {
+				case "abuse":
+					orgInfo.Abuse = append(orgInfo.Abuse, contact)
+				case "tech":
+					orgInfo.Technical = append(orgInfo.Technical, contact)
+				case "noc":
+					orgInfo.NOC = append(orgInfo.NOC, contact)
+				case "admin":
+					orgInfo.Administrative = append(orgInfo.Administrative, contact)
+				case "routing":
+					orgInfo.Routing = append(orgInfo.Routing, contact)
+				case "dns":
+					orgInfo.DNS = append(orgInfo.DNS, contact)
+				}
+				continue
+			}
+			if contact == nil {
+				continue
+			}
+			switch m[2] {
+			case "name":
+				contact.Name = value
+			case "phone":
+				contact.Phone = value
+			case "email":
+				contact.Email = strings.ToLower(value)
+			case "ref":
+				contact.ReferralURL = value
+			}
+			continue
+		}
+
+		switch key {
+		case "orgid":
+			orgInfo.Handle = value
+		case "orgname":
+			orgInfo.Name = value
+		case "address":
+			orgInfo.Street = strings.TrimSpace(orgInfo.Street + "\n" + value)
+		case "city":
+			orgInfo.City = value
+		case "stateprov":
+			orgInfo.Province = value
+		case "postalcode":
+			orgInfo.PostalCode = value
+		case "country":
+			orgInfo.Country = value
+		case "regdate":
+			orgInfo.RegDate = value
+		case "updated":
+			orgInfo.Updated = value
+		case "comment":
+			orgInfo.Comment = strings.TrimSpace(orgInfo.Comment + "\n" + value)
+		case "ref":
+			orgInfo.Ref = value
+		}
+	}
+
+	if orgInfo.Handle == "" {
+		err = getOrgErrorType(text)
+		return
+	}
+
+	orgInfo.RegDateInTime, orgInfo.RegDateZoneAssumed, orgInfo.RegDateApproximate = parseDateTime(orgInfo.RegDate, "arin")
+	orgInfo.UpdatedInTime, orgInfo.UpdatedZoneAssumed, orgInfo.UpdatedApproximate = parseDateTime(orgInfo.Updated, "arin")
+
+	whoisInfo.Org = orgInfo
+	return
+}
+
+// ParsePOCWhois parses ARIN point of contact whois information
+func ParsePOCWhois(text string) (whoisInfo WhoisInfo, err error) {
+	pocInfo := &POCInfo{}
+
+	for _, line := range strings.Split(text, "\n") {
+		key, value, ok := splitARINLine(line)
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "handle", "pochandle":
+			pocInfo.Handle = value
+		case "name", "pocname":
+			pocInfo.Name = value
+		case "company":
+			pocInfo.Company = value
+		case "address":
+			pocInfo.Street = strings.TrimSpace(pocInfo.Street + "\n" + value)
+		case "city":
+			pocInfo.City = value
+		case "stateprov":
+			pocInfo.Province = value
+		case "postalcode":
+			pocInfo.PostalCode = value
+		case "country":
+			pocInfo.Country = value
+		case "phone":
+			pocInfo.Phones = append(pocInfo.Phones, value)
+		case "email", "mailbox":
+			pocInfo.Emails = append(pocInfo.Emails, strings.ToLower(value))
+		case "regdate":
+			pocInfo.RegDate = value
+		case "updated":
+			pocInfo.Updated = value
+		case "comment":
+			pocInfo.Comment = strings.TrimSpace(pocInfo.Comment + "\n" + value)
+		case "ref":
+			pocInfo.Ref = value
+		}
+	}
+
+	if pocInfo.Handle == "" {
+		err = getPOCErrorType(text)
+		return
+	}
+
+	pocInfo.RegDateInTime, pocInfo.RegDateZoneAssumed, pocInfo.RegDateApproximate = parseDateTime(pocInfo.RegDate, "arin")
+	pocInfo.UpdatedInTime, pocInfo.UpdatedZoneAssumed, pocInfo.UpdatedApproximate = parseDateTime(pocInfo.Updated, "arin")
+
+	whoisInfo.POC = pocInfo
+	return
+}
+
+// splitARINLine returns the lowercase key and the value of ARIN whois line, comments are skipped
+func splitARINLine(line string) (key, value string, ok bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", "", false
+	}
+
+	key, value, ok = strings.Cut(line, ":")
+	if !ok {
+		return "", "", false
+	}
+
+	return strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value), true
+}
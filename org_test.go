@@ -0,0 +1,126 @@
+package whoisparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const arinOrgWhois = `
+#
+# ARIN WHOIS data and services are subject to the Terms of Use
+# available at: https://www.arin.net/resources/registry/whois/tou/
+#
+
+OrgName:        Google LLC
+OrgId:          GOGL
+Address:        1600 Amphitheatre Parkway
+City:           Mountain View
+StateProv:      CA
+PostalCode:     94043
+Country:        US
+RegDate:        2000-03-30
+Updated:        2019-10-31
+Comment:        Please note that the recommended way to file abuse complaints are located in the following links.
+Comment:
+Comment:        To report abuse and illegal activity: https://www.google.com/contact/
+Ref:            https://rdap.arin.net/registry/entity/GOGL
+
+OrgTechHandle: ZG39-ARIN
+OrgTechName:   Google LLC
+OrgTechPhone:  +1-650-253-0000
+OrgTechEmail:  arin-contact@google.com
+OrgTechRef:    https://rdap.arin.net/registry/entity/ZG39-ARIN
+
+OrgAbuseHandle: ABUSE5250-ARIN
+OrgAbuseName:   Abuse
+OrgAbusePhone:  +1-650-253-0000
+OrgAbuseEmail:  network-abuse@google.com
+OrgAbuseRef:    https://rdap.arin.net/registry/entity/ABUSE5250-ARIN
+
+OrgTechHandle: ZG39-ARIN
+OrgTechName:   Google LLC
+OrgTechPhone:  +1-650-253-0000
+OrgTechEmail:  Arin-Contact@google.com
+OrgTechRef:    https://rdap.arin.net/registry/entity/ZG39-ARIN
+`
+
+const arinPOCWhois = `
+Name:           Google LLC
+Handle:         ZG39-ARIN
+Company:        Google LLC
+Address:        1600 Amphitheatre Parkway
+City:           Mountain View
+StateProv:      CA
+PostalCode:     94043
+Country:        US
+RegDate:        2000-11-30
+Updated:        2024-05-23
+Phone:          +1-650-253-0000 (Office)
+Email:          arin-contact@google.com
+Ref:            https://rdap.arin.net/registry/entity/ZG39-ARIN
+`
+
+// TestParseOrgWhois tests that ARIN organization records are parsed with their contacts by role.
+func TestParseOrgWhois(t *testing.T) {
+	assert.True(t, isARINOrgWhois(arinOrgWhois))
+	assert.False(t, isARINPOCWhois(arinOrgWhois))
+	assert.False(t, isIPWhois(arinOrgWhois))
+
+	result, err := Parse(arinOrgWhois)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Domain)
+
+	org := result.Org
+	assert.Equal(t, "GOGL", org.Handle)
+	assert.Equal(t, "Google LLC", org.Name)
+	assert.Equal(t, "1600 Amphitheatre Parkway", org.Street)
+	assert.Equal(t, "Mountain View", org.City)
+	assert.Equal(t, "CA", org.Province)
+	assert.Equal(t, "94043", org.PostalCode)
+	assert.Equal(t, "US", org.Country)
+	assert.Equal(t, 2000, org.RegDateInTime.Year())
+	assert.Equal(t, 2019, org.UpdatedInTime.Year())
+	assert.False(t, org.RegDateApproximate)
+	assert.Contains(t, org.Comment, "links.\nTo report abuse")
+	assert.Equal(t, "https://rdap.arin.net/registry/entity/GOGL", org.Ref)
+
+	assert.Equal(t, 1, len(org.Abuse))
+	assert.Equal(t, "ABUSE5250-ARIN", org.Abuse[0].ID)
+	assert.Equal(t, "network-abuse@google.com", org.Abuse[0].Email)
+	assert.Equal(t, 2, len(org.Technical))
+	assert.Equal(t, "ZG39-ARIN", org.Technical[1].ID)
+	assert.Equal(t, "arin-contact@google.com", org.Technical[1].Email)
+	assert.Nil(t, org.NOC)
+
+	_, err = ParseOrgWhois("No match found for o + NOSUCHORG.\n")
+	assert.Equal(t, ErrNotFoundOrg, err)
+}
+
+// TestParsePOCWhois tests that ARIN point of contact records are parsed.
+func TestParsePOCWhois(t *testing.T) {
+	assert.True(t, isARINPOCWhois(arinPOCWhois))
+	assert.False(t, isARINOrgWhois(arinPOCWhois))
+
+	result, err := Parse(arinPOCWhois)
+	assert.Nil(t, err)
+
+	poc := result.POC
+	assert.Equal(t, "ZG39-ARIN", poc.Handle)
+	assert.Equal(t, "Google LLC", poc.Name)
+	assert.Equal(t, "Google LLC", poc.Company)
+	assert.Equal(t, "1600 Amphitheatre Parkway", poc.Street)
+	assert.Equal(t, []string{"+1-650-253-0000 (Office)"}, poc.Phones)
+	assert.Equal(t, []string{"arin-contact@google.com"}, poc.Emails)
+	assert.Equal(t, 2024, poc.UpdatedInTime.Year())
+	assert.False(t, poc.UpdatedApproximate)
+
+	result, err = ParsePOCWhois("POCHandle: JD1-ARIN\nPOCName: Doe, John\nMailbox: JDoe@example.com\n")
+	assert.Nil(t, err)
+	assert.Equal(t, "JD1-ARIN", result.POC.Handle)
+	assert.Equal(t, "Doe, John", result.POC.Name)
+	assert.Equal(t, []string{"jdoe@example.com"}, result.POC.Emails)
+
+	_, err = ParsePOCWhois("No match found for p + NOSUCH-ARIN.\n")
+	assert.Equal(t, ErrNotFoundPOC, err)
+}
@@ -20,7 +20,8 @@
 package whoisparser
 
 import (
-	"errors"
+	"fmt"
+	"net/netip"
 	"regexp"
 	"strings"
 
@@ -46,17 +47,50 @@ func License() string {
 
 // Parse returns parsed whois info for domain, IP, or AS
 func Parse(text string) (whoisInfo WhoisInfo, err error) {
-	if isASWhois(text) {
+	if isCymruWhois(text) {
+		return ParseCymruWhois(text)
+	} else if isASWhois(text) {
 		return ParseASWhois(text)
+	} else if isRPSLSetWhois(text) {
+		return ParseSetWhois(text)
+	} else if isReverseWhois(text) {
+		return ParseReverseWhois(text)
 	} else if isIPWhois(text) {
 		return ParseIPWhois(text)
+	} else if isARINOrgWhois(text) {
+		return ParseOrgWhois(text)
+	} else if isARINPOCWhois(text) {
+		return ParsePOCWhois(text)
 	} else {
 		return ParseDomainWhois(text)
 	}
 }
 
-// parseDomainWhois parses domain whois information
-func ParseDomainWhois(text string) (whoisInfo WhoisInfo, err error) { //nolint:cyclop
+// ParseFor returns parsed whois info for the given query, which may be a domain, IP, or AS.
+// Extraction is anchored on the query instead of being guessed from the text, and
+// ErrQueryMismatch is returned along with the parsed info if the response is about another object.
+func ParseFor(query, text string) (whoisInfo WhoisInfo, err error) {
+	query = strings.TrimSpace(query)
+
+	if addr, e := netip.ParseAddr(query); e == nil {
+		return parseIPWhoisFor(addr, text)
+	}
+
+	if prefix, e := netip.ParsePrefix(query); e == nil {
+		return parseIPWhoisFor(prefix.Addr(), text)
+	}
+
+	if m := searchASNQueryRx.FindStringSubmatch(query); len(m) > 0 {
+		if asn, e := ParseASN(m[1]); e == nil {
+			return parseASWhoisFor(asn, text)
+		}
+	}
+
+	return parseDomainWhoisFor(query, text)
+}
+
+// ParseDomainWhois parses domain whois information
+func ParseDomainWhois(text string) (whoisInfo WhoisInfo, err error) {
 	name, extension := searchDomain(text)
 	if name == "" {
 		err = getDomainErrorType(text)
@@ -68,6 +102,48 @@ func ParseDomainWhois(text string) (whoisInfo WhoisInfo, err error) { //nolint:c
 		return
 	}
 
+	return parseDomainWhois(text, name, extension, "")
+}
+
+// parseDomainWhoisFor parses domain whois information of the queried domain
+func parseDomainWhoisFor(query, text string) (whoisInfo WhoisInfo, err error) {
+	query = strings.ToLower(strings.TrimSuffix(query, "."))
+	punycode, e := idna.ToASCII(query)
+	if e != nil || punycode == "" {
+		return ParseDomainWhois(text)
+	}
+
+	name, extension := punycode, ""
+	if pos := strings.LastIndex(punycode, "."); pos > 0 {
+		name, extension = punycode[:pos], punycode[pos+1:]
+	}
+
+	if extension != "" && isExtNotFoundDomain(text, extension) {
+		err = ErrNotFoundDomain
+		return
+	}
+
+	whoisInfo, err = parseDomainWhois(text, name, extension, punycode)
+	if err != nil {
+		return
+	}
+
+	if found := strings.TrimSuffix(whoisInfo.Domain.Punycode, "."); found != punycode {
+		// the name and extension are of the domain in the response, not of the query
+		if pos := strings.LastIndex(found, "."); pos > 0 {
+			whoisInfo.Domain.Name, whoisInfo.Domain.Extension = found[:pos], found[pos+1:]
+		}
+		err = ErrQueryMismatch
+	}
+
+	return
+}
+
+// parseDomainWhois parses domain whois information of the domain name and extension,
+// if query is not empty, only the domain name lines matching it are taken
+func parseDomainWhois(text, name, extension, query string) (whoisInfo WhoisInfo, err error) { //nolint:cyclop
+	otherDomain := ""
+
 	domain := &Domain{}
 	registrar := &Contact{}
 	registrant := &Contact{}
@@ -122,8 +198,16 @@ func ParseDomainWhois(text string) (whoisInfo WhoisInfo, err error) { //nolint:c
 				if firstSpace := strings.IndexByte(value, ' '); firstSpace > 0 {
 					value = value[:firstSpace]
 				}
-				domain.Domain = strings.ToLower(value)
-				domain.Punycode, _ = idna.ToASCII(domain.Domain)
+				value = strings.ToLower(value)
+				punycode, _ := idna.ToASCII(value)
+				if query != "" && strings.TrimSuffix(punycode, ".") != query {
+					if otherDomain == "" {
+						otherDomain = value
+					}
+					continue
+				}
+				domain.Domain = value
+				domain.Punycode = punycode
 			}
 		case "domain_status":
 			domain.Status = append(domain.Status, strings.Split(value, ",")...)
@@ -140,22 +224,28 @@ func ParseDomainWhois(text string) (whoisInfo WhoisInfo, err error) { //nolint:c
 		case "created_date":
 			if domain.CreatedDate == "" {
 				domain.CreatedDate = value
-				if parsed, err := parseDateString(value); err == nil {
-					domain.CreatedDateInTime = &parsed
+				if parsed, err := parseDateString(value, domain.Extension); err == nil {
+					domain.CreatedDateInTime = &parsed.Time
+					domain.CreatedDateZoneAssumed = !parsed.ZoneExplicit
+					domain.CreatedDateApproximate = parsed.Approximate
 				}
 			}
 		case "updated_date":
 			if domain.UpdatedDate == "" {
 				domain.UpdatedDate = value
-				if parsed, err := parseDateString(value); err == nil {
-					domain.UpdatedDateInTime = &parsed
+				if parsed, err := parseDateString(value, domain.Extension); err == nil {
+					domain.UpdatedDateInTime = &parsed.Time
+					domain.UpdatedDateZoneAssumed = !parsed.ZoneExplicit
+					domain.UpdatedDateApproximate = parsed.Approximate
 				}
 			}
 		case "expired_date":
 			if domain.ExpirationDate == "" {
 				domain.ExpirationDate = value
-				if parsed, err := parseDateString(value); err == nil {
-					domain.ExpirationDateInTime = &parsed
+				if parsed, err := parseDateString(value, domain.Extension); err == nil {
+					domain.ExpirationDateInTime = &parsed.Time
+					domain.ExpirationDateZoneAssumed = !parsed.ZoneExplicit
+					domain.ExpirationDateApproximate = parsed.Approximate
 				}
 			}
 		case "referral_url":
@@ -187,6 +277,15 @@ func ParseDomainWhois(text string) (whoisInfo WhoisInfo, err error) { //nolint:c
 		}
 	}
 
+	if query != "" && domain.Domain == "" {
+		if otherDomain == "" {
+			err = getDomainErrorType(text)
+			return
+		}
+		domain.Domain = otherDomain
+		domain.Punycode, _ = idna.ToASCII(otherDomain)
+	}
+
 	domain.NameServers = fixNameServers(domain.NameServers)
 	domain.Status = fixDomainStatus(domain.Status)
 
@@ -219,6 +318,33 @@ func ParseDomainWhois(text string) (whoisInfo WhoisInfo, err error) { //nolint:c
 
 // ParseIPWhois parses IP WHOIS information.
 func ParseIPWhois(text string) (whoisInfo WhoisInfo, err error) {
+	var ipInfo *IPInfo
+	registry := searchRegistry(text)
+	if nir := searchNIR(text); nir != "" {
+		ipInfo = parseNIRIPWhois(nir, text)
+		registry = nir
+	} else if isRPSLIPWhois(text) {
+		ipInfo = parseRPSLIPWhois(text)
+	} else {
+		ipInfo = parseARINIPWhois(text)
+		ipInfo.Matches = parseARINMatches(text)
+	}
+
+	if len(ipInfo.Networks) == 0 && len(ipInfo.Routes) == 0 && len(ipInfo.Matches) == 0 {
+		err = getIPErrorType(text)
+		return
+	}
+
+	normalizeIPNetworks(ipInfo)
+	ipInfo.Warnings = append(ipInfo.Warnings, ipInfo.OriginConflicts()...)
+	parseIPDateTimes(ipInfo, registry)
+
+	whoisInfo.IP = ipInfo
+	return
+}
+
+// parseARINIPWhois parses IP WHOIS information in the ARIN format
+func parseARINIPWhois(text string) *IPInfo { //nolint:cyclop
 	ipInfo := &IPInfo{
 		Networks: []*Network{},
 	}
@@ -327,7 +453,7 @@ func ParseIPWhois(text string) (whoisInfo WhoisInfo, err error) {
 				currentSection = "customer"
 			}
 		// Assign RegDate and Updated based on current section
-		case "regdate":
+		case "regdate", "created":
 			if currentNetwork != nil {
 				switch currentSection {
 				case "organization":
@@ -341,8 +467,10 @@ func ParseIPWhois(text string) (whoisInfo WhoisInfo, err error) {
 				default:
 					currentNetwork.RegDate = value
 				}
+			} else if fallbackNetworkInfo.RegDate == "" {
+				fallbackNetworkInfo.RegDate = value
 			}
-		case "updated":
+		case "updated", "last-modified":
 			if currentNetwork != nil {
 				switch currentSection {
 				case "organization":
@@ -356,6 +484,8 @@ func ParseIPWhois(text string) (whoisInfo WhoisInfo, err error) {
 				default:
 					currentNetwork.Updated = value
 				}
+			} else if fallbackNetworkInfo.Updated == "" {
+				fallbackNetworkInfo.Updated = value
 			}
 		case "ref":
 			if currentNetwork != nil {
@@ -439,8 +569,8 @@ func ParseIPWhois(text string) (whoisInfo WhoisInfo, err error) {
 						currentNetwork.Customer.Country = value
 					}
 				}
-			} else {
-				fallbackNetworkInfo.OrganizationName = value
+			} else if fallbackNetworkInfo.Country == "" {
+				fallbackNetworkInfo.Country = value
 			}
 		case "comment":
 			if currentNetwork != nil {
@@ -550,20 +680,71 @@ func ParseIPWhois(text string) (whoisInfo WhoisInfo, err error) {
 		}
 	}
 
-	whoisInfo.IP = ipInfo
+	return ipInfo
+}
+
+// parseIPWhoisFor parses IP WHOIS information of the queried address
+func parseIPWhoisFor(addr netip.Addr, text string) (whoisInfo WhoisInfo, err error) {
+	whoisInfo, err = ParseIPWhois(text)
+	if err != nil {
+		return
+	}
+
+	checked := false
+	for _, network := range whoisInfo.IP.Networks {
+		ranges := append([]string{network.Range}, network.CIDR...)
+		for _, v := range ranges {
+			start, end, ok := parseIPRange(v)
+			if !ok {
+				continue
+			}
+			if start.BitLen() == addr.BitLen() && start.Compare(addr) <= 0 && end.Compare(addr) >= 0 {
+				return
+			}
+			checked = true
+		}
+	}
+
+	if checked {
+		err = ErrQueryMismatch
+	}
+
+	return
+}
+
+// parseASWhoisFor parses AS WHOIS information of the queried AS number
+func parseASWhoisFor(asn ASN, text string) (whoisInfo WhoisInfo, err error) {
+	whoisInfo, err = ParseASWhois(text)
+	if err != nil {
+		return
+	}
+
+	if asn < whoisInfo.AS.Start || asn > whoisInfo.AS.End {
+		err = ErrQueryMismatch
+	}
+
 	return
 }
 
 // parseASWhois parses AS WHOIS information.
 func ParseASWhois(text string) (whoisInfo WhoisInfo, err error) {
+	if isRPSLASWhois(text) {
+		registry := searchRegistry(text)
+		asInfo := parseRPSLASWhois(text)
+		if err = validateASInfo(asInfo, registry); err != nil {
+			return
+		}
+		asInfo.Registry = registry
+		normalizeASInfo(asInfo)
+		parseASDateTimes(asInfo, registry)
+		whoisInfo.AS = asInfo
+		return
+	}
+
 	asInfo := &ASInfo{}
 	whoisLines := strings.Split(text, "\n")
 	currentSection := ""
 
-	// Flags to check mandatory fields
-	hasASNumber := false
-	hasASHandle := false
-
 	for _, line := range whoisLines {
 		line = strings.TrimSpace(line)
 		// Skip empty lines and comments
@@ -583,12 +764,10 @@ func ParseASWhois(text string) (whoisInfo WhoisInfo, err error) {
 		// AS Basic Information
 		case "asnumber", "as-number", "as number", "aut-num":
 			asInfo.Number = strings.TrimPrefix(value, "AS")
-			hasASNumber = true
 		case "asname", "as-name", "as name":
 			asInfo.Name = value
 		case "ashandle", "as-handle", "as handle":
 			asInfo.Handle = value
-			hasASHandle = true
 		case "regdate", "registration-date", "created":
 			if currentSection == "organization" && asInfo.Organization != nil {
 				asInfo.Organization.RegistrationDate = value
@@ -717,15 +896,13 @@ func ParseASWhois(text string) (whoisInfo WhoisInfo, err error) {
 	}
 
 	// Validate mandatory fields
-	if !hasASNumber {
-		err = errors.New("ASNumber is missing")
-		return
-	}
-	if !hasASHandle {
-		err = errors.New("ASHandle is missing")
+	if err = validateASInfo(asInfo, "arin"); err != nil {
 		return
 	}
 
+	asInfo.Registry = "arin"
+	normalizeASInfo(asInfo)
+
 	// Trim any trailing newlines or spaces
 	if asInfo.Organization != nil {
 		asInfo.Organization.Street = strings.TrimSpace(asInfo.Organization.Street)
@@ -741,26 +918,191 @@ func ParseASWhois(text string) (whoisInfo WhoisInfo, err error) {
 		asInfo.Abuse.Street = strings.TrimSpace(asInfo.Abuse.Street)
 	}
 
+	parseASDateTimes(asInfo, asInfo.Registry)
+
 	whoisInfo.AS = asInfo
 	return
 }
 
+// asRequiredFields is the fields required in AS WHOIS information by registry, named as in the registry,
+// the RPSL registries not listed require aut-num only
+var asRequiredFields = map[string][]string{
+	"arin":    {"ASNumber", "ASHandle"},
+	"ripe":    {"aut-num", "as-name"},
+	"apnic":   {"aut-num", "as-name"},
+	"afrinic": {"aut-num", "as-name"},
+	"lacnic":  {"aut-num"},
+}
+
+// validateASInfo returns ErrASDataInvalid wrapped error if a field required by the registry is missing
+func validateASInfo(asInfo *ASInfo, registry string) error {
+	fields, ok := asRequiredFields[registry]
+	if !ok {
+		fields = []string{"aut-num"}
+	}
+
+	for _, field := range fields {
+		value := ""
+		switch field {
+		case "ASNumber", "aut-num":
+			value = asInfo.Number
+		case "ASHandle":
+			value = asInfo.Handle
+		case "as-name":
+			value = asInfo.Name
+		}
+		if value == "" {
+			return fmt.Errorf("%w: %s is missing", ErrASDataInvalid, field)
+		}
+	}
+
+	return nil
+}
+
+// normalizeASInfo fills the typed AS number range of the AS WHOIS information,
+// the number is written as "7018", "1.10" in asdot notation or "7018 - 7019" for ranges by ARIN,
+// the range is left as zero if the number is malformed
+func normalizeASInfo(asInfo *ASInfo) {
+	asInfo.Start, asInfo.End, _ = ParseASNRange(asInfo.Number)
+}
+
+// normalizeIPNetworks rewrites the ranges and CIDR prefixes of the networks in canonical form
+// and fills their typed values and allocation status, a range given without prefix is covered by the computed prefixes,
+// malformed ranges and prefixes are reported in the warnings
+func normalizeIPNetworks(ipInfo *IPInfo) {
+	for _, network := range ipInfo.Networks {
+		network.Status, network.Portable = parseAllocationStatus(network.Type)
+
+		for i, cidr := range network.CIDR {
+			prefix, err := netip.ParsePrefix(cidr)
+			if err != nil {
+				ipInfo.Warnings = append(ipInfo.Warnings, fmt.Sprintf("malformed CIDR %q of network %s", cidr, networkLabel(network)))
+				continue
+			}
+			network.CIDR[i] = prefix.Masked().String()
+			network.Prefixes = append(network.Prefixes, prefix.Masked())
+		}
+
+		if network.Range == "" {
+			continue
+		}
+
+		start, end, ok := parseIPRange(expandIPv4Prefix(network.Range))
+		if !ok {
+			ipInfo.Warnings = append(ipInfo.Warnings, fmt.Sprintf("malformed range %q of network %s", network.Range, networkLabel(network)))
+			continue
+		}
+
+		network.Range = start.String() + " - " + end.String()
+		network.Start = start
+		network.End = end
+
+		if len(network.CIDR) == 0 {
+			network.Prefixes = ipRangePrefixes(start, end)
+			for _, prefix := range network.Prefixes {
+				network.CIDR = append(network.CIDR, prefix.String())
+			}
+		}
+	}
+}
+
+// networkLabel returns the name of the network, or its handle or range if it has no name as LACNIC networks
+func networkLabel(network *Network) string {
+	switch {
+	case network.Name != "":
+		return network.Name
+	case network.Handle != "":
+		return network.Handle
+	}
+
+	return network.Range
+}
+
+// parseIPDateTimes fills the time values of the IP WHOIS information
+func parseIPDateTimes(ipInfo *IPInfo, registry string) {
+	for _, network := range ipInfo.Networks {
+		network.RegDateInTime, network.RegDateZoneAssumed, network.RegDateApproximate = parseDateTime(network.RegDate, registry)
+		network.UpdatedInTime, network.UpdatedZoneAssumed, network.UpdatedApproximate = parseDateTime(network.Updated, registry)
+		parseContactDateTimes(network.Organization, registry)
+		parseContactDateTimes(network.Customer, registry)
+		parseContactDateTimes(network.Abuse, registry)
+		for _, contact := range network.Administrative {
+			parseContactDateTimes(contact, registry)
+		}
+		for _, contact := range network.Technical {
+			parseContactDateTimes(contact, registry)
+		}
+	}
+
+	parseContactDateTimes(ipInfo.Abuse, registry)
+	parseContactDateTimes(ipInfo.Technical, registry)
+	parseContactDateTimes(ipInfo.Routing, registry)
+}
+
+// parseASDateTimes fills the time values of the AS WHOIS information
+func parseASDateTimes(asInfo *ASInfo, registry string) {
+	asInfo.RegDateInTime, asInfo.RegDateZoneAssumed, asInfo.RegDateApproximate = parseDateTime(asInfo.RegDate, registry)
+	asInfo.UpdatedInTime, asInfo.UpdatedZoneAssumed, asInfo.UpdatedApproximate = parseDateTime(asInfo.Updated, registry)
+
+	parseContactDateTimes(asInfo.Organization, registry)
+	parseContactDateTimes(asInfo.Administrative, registry)
+	parseContactDateTimes(asInfo.Abuse, registry)
+	parseContactDateTimes(asInfo.Technical, registry)
+	parseContactDateTimes(asInfo.Routing, registry)
+
+	for _, contacts := range [][]*Contact{asInfo.AdministrativeContacts, asInfo.RoutingContacts, asInfo.TechnicalContacts} {
+		for _, contact := range contacts {
+			parseContactDateTimes(contact, registry)
+		}
+	}
+}
+
+// parseContactDateTimes fills the time values of the contact
+func parseContactDateTimes(contact *Contact, registry string) {
+	if contact == nil {
+		return
+	}
+
+	contact.RegistrationDateInTime, contact.RegistrationDateZoneAssumed, contact.RegistrationDateApproximate = parseDateTime(contact.RegistrationDate, registry)
+	contact.UpdatedInTime, contact.UpdatedZoneAssumed, contact.UpdatedApproximate = parseDateTime(contact.Updated, registry)
+}
+
+// searchRegistry returns the registry which the IP or AS WHOIS information comes from
+func searchRegistry(text string) string {
+	if m := searchRegistryRx.FindStringSubmatch(text); len(m) > 0 {
+		return strings.ToLower(m[1])
+	}
+
+	lower := strings.ToLower(text)
+	for _, registry := range []string{"arin", "ripe", "apnic", "afrinic", "lacnic"} {
+		if strings.Contains(lower, "whois."+registry+".net") {
+			return registry
+		}
+	}
+
+	if strings.Contains(text, "NetRange:") || strings.Contains(text, "ASNumber:") {
+		return "arin"
+	}
+
+	return ""
+}
+
 // isIPWhois checks if the WHOIS text is for an IP address
 func isIPWhois(text string) bool {
 	// Check for typical IP WHOIS keywords
-	ipKeywords := []string{"NetRange:", "CIDR:", "inetnum:", "inet6num:"}
+	ipKeywords := []string{"NetRange:", "CIDR:", "inetnum:", "inet6num:", "route:", "route6:"}
 
 	for _, keyword := range ipKeywords {
 		if strings.Contains(text, keyword) {
 			return true
 		}
 	}
-	return false
+	return searchNIR(text) != "" || isARINSummaryWhois(text)
 }
 
 // isASWhois checks if the WHOIS text is for an AS number
 func isASWhois(text string) bool {
-	return strings.Contains(text, "ASNumber:") || strings.Contains(text, "ASName:") || strings.Contains(text, "aut-num:")
+	return strings.Contains(text, "ASNumber:") || strings.Contains(text, "ASName:") || isRPSLASWhois(text)
 }
 
 // parseContact do parse contact info
@@ -803,6 +1145,10 @@ func parseContact(contact *Contact, name, value string) {
 	}
 }
 
+var searchRegistryRx = regexp.MustCompile(`(?im)^source:\s*(ARIN|RIPE|APNIC|AFRINIC|LACNIC|JPNIC|KRNIC|TWNIC|CNNIC)\b`)
+
+var searchASNQueryRx = regexp.MustCompile(`^(?i)(?:AS)?(\d+(?:\.\d+)?)$`)
+
 var searchDomainRx1 = regexp.MustCompile(`(?i)\[?domain\:?(\s*\_?name)?\]?[\s\.]*\:?` +
 	`\s*([^\s\,\;\@\(\)]+)\.([^\s\,\;\(\)\.]{2,})`)
 var searchDomainRx2 = regexp.MustCompile(`(?i)\[?domain\:?(\s*\_?name)?\]?[\s\.]*\:?` +
@@ -245,3 +245,46 @@ func TestAssearchDomain(t *testing.T) {
 		assert.Equal(t, extension, v.extension)
 	}
 }
+
+func TestParseFor(t *testing.T) {
+	whoisRaw, err := xfile.ReadText(noterrorDir + "/com_google.com")
+	assert.Nil(t, err)
+
+	whoisInfo, err := ParseFor("google.com", whoisRaw)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "google.com")
+	assert.Equal(t, whoisInfo.Domain.Name, "google")
+	assert.Equal(t, whoisInfo.Domain.Extension, "com")
+
+	whoisInfo, err = ParseFor("GOOGLE.COM.", whoisRaw)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Punycode, "google.com")
+
+	whoisInfo, err = ParseFor("example.org", whoisRaw)
+	assert.Equal(t, err, ErrQueryMismatch)
+	assert.Equal(t, whoisInfo.Domain.Domain, "google.com")
+	assert.Equal(t, whoisInfo.Domain.Name, "google")
+	assert.Equal(t, whoisInfo.Domain.Extension, "com")
+
+	// the registrar URL before domain: misleads the guess of domain name from the text
+	whoisRaw = `Registrar URL: http://www.my-domain.registrar.net
+domain:        likexian.pl
+registered:    2020-01-02
+nameservers:   ns1.likexian.pl.`
+	whoisInfo, err = Parse(whoisRaw)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Name, "registrar")
+	assert.Equal(t, whoisInfo.Domain.Extension, "net")
+
+	whoisInfo, err = ParseFor("likexian.pl", whoisRaw)
+	assert.Nil(t, err)
+	assert.Equal(t, whoisInfo.Domain.Domain, "likexian.pl")
+	assert.Equal(t, whoisInfo.Domain.Name, "likexian")
+	assert.Equal(t, whoisInfo.Domain.Extension, "pl")
+	assert.Equal(t, whoisInfo.Domain.CreatedDate, "2020-01-02")
+
+	whoisRaw, err = xfile.ReadText(notfoundDir + "/com_likexian-have-no-money-to-register.com")
+	assert.Nil(t, err)
+	_, err = ParseFor("likexian-have-no-money-to-register.com", whoisRaw)
+	assert.Equal(t, err, ErrNotFoundDomain)
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/likexian/gokit/assert"
+)
+
+// Policy is a routing policy of aut-num object, from import, export, mp-import, mp-export or default
+type Policy struct {
+	// Direction is "import", "export" or "default"
+	Direction string `json:"direction"`
+	// PeerAS is the peer of the policy, an AS number such as "AS1103" or an as-set
+	PeerAS string `json:"peer_as"`
+	// Filter is the accepted, announced or default networks filter, such as "ANY" or "AS-RIPENCC"
+	Filter string `json:"filter,omitempty"`
+	// Action is the action of the policy without the action keyword, such as "pref=100"
+	Action string `json:"action,omitempty"`
+	// AFI is the address family of the policy, "ipv4.unicast" for import, export and default
+	AFI string `json:"afi"`
+}
+
+var (
+	policyImportRx  = regexp.MustCompile(`(?i)^(?:afi\s+(\S+)\s+)?from\s+(\S+)(.*?)\s+accept\s+(.+?)\s*;?$`)
+	policyExportRx  = regexp.MustCompile(`(?i)^(?:afi\s+(\S+)\s+)?to\s+(\S+)(.*?)\s+announce\s+(.+?)\s*;?$`)
+	policyDefaultRx = regexp.MustCompile(`(?i)^(?:afi\s+(\S+)\s+)?to\s+(\S+)(.*?)(?:\s+networks\s+(.+?))?\s*;?$`)
+	policyActionRx  = regexp.MustCompile(`(?i)\baction\s+(.+?)\s*;`)
+)
+
+// parseRPSLPolicies returns the routing policies of the aut-num object, skipping the ones not understood,
+// such as structured policies with refine or except
+func parseRPSLPolicies(object Object) []Policy {
+	policies := []Policy{}
+
+	for _, attribute := range object.Attributes {
+		name := attribute.Name
+		afi := "ipv4.unicast"
+		if strings.HasPrefix(name, "mp-") {
+			name = strings.TrimPrefix(name, "mp-")
+			afi = "any"
+		}
+
+		var rx *regexp.Regexp
+		switch name {
+		case "import":
+			rx = policyImportRx
+		case "export":
+			rx = policyExportRx
+		case "default":
+			rx = policyDefaultRx
+		default:
+			continue
+		}
+
+		value := strings.Join(strings.Fields(attribute.Value), " ")
+		m := rx.FindStringSubmatch(value)
+		if len(m) == 0 {
+			continue
+		}
+
+		policy := Policy{
+			Direction: name,
+			PeerAS:    strings.ToUpper(m[2]),
+			Filter:    m[4],
+			AFI:       afi,
+		}
+
+		if m[1] != "" {
+			policy.AFI = strings.ToLower(m[1])
+		}
+
+		if action := policyActionRx.FindStringSubmatch(m[3] + ";"); len(action) > 0 {
+			policy.Action = action[1]
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
+
+// classifyPolicyPeers returns the upstream, peer and downstream ASes of the policies, an AS we accept ANY
+// from or default to is an upstream, an AS we announce ANY to is a downstream, and other ASes are peers
+func classifyPolicyPeers(policies []Policy) (upstreams, peers, downstreams []string) {
+	for _, policy := range policies {
+		full := strings.EqualFold(policy.Filter, "ANY")
+		switch {
+		case policy.Direction == "default", policy.Direction == "import" && full:
+			upstreams = appendUnique(upstreams, policy.PeerAS)
+		case policy.Direction == "export" && full:
+			downstreams = appendUnique(downstreams, policy.PeerAS)
+		}
+	}
+
+	for _, policy := range policies {
+		if !assert.IsContains(upstreams, policy.PeerAS) && !assert.IsContains(downstreams, policy.PeerAS) {
+			peers = appendUnique(peers, policy.PeerAS)
+		}
+	}
+
+	return
+}
+
+// appendUnique returns the values with the value appended if it is not present
+func appendUnique(values []string, value string) []string {
+	if assert.IsContains(values, value) {
+		return values
+	}
+
+	return append(values, value)
+}
+
+// parseRPSLMemberOf returns the sets of the member-of attributes, which may list several sets separated by comma
+func parseRPSLMemberOf(object Object) []string {
+	var sets []string
+	for _, value := range object.GetAll("member-of") {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				sets = appendUnique(sets, strings.ToUpper(v))
+			}
+		}
+	}
+
+	return sets
+}
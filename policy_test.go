@@ -0,0 +1,46 @@
+package whoisparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseASWhoisPolicies tests that routing policies are parsed and peers classified.
+func TestParseASWhoisPolicies(t *testing.T) {
+	input := `
+aut-num:        AS3333
+as-name:        RIPE-NCC-AS
+member-of:      AS-RIPENCC, as-example
+import:         from AS1103 action pref=100; accept ANY
+export:         to AS1103 announce AS3333
+mp-import:      afi ipv6.unicast from AS1103 accept ANY
+import:         from AS12859 accept AS-BIT
+export:         to AS12859 announce AS3333
+import:         from AS64500 192.0.2.1 at 192.0.2.2 accept AS64500
+export:         to AS64500
+                announce ANY
+mp-export:      to AS64501 announce AS-RIPENCC
+default:        to AS1103 action pref=100; networks ANY
+import:         { from AS-ANY accept ANY; } refine { from AS1 accept ANY; }
+source:         RIPE
+`
+	result, err := ParseASWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, []Policy{
+		{Direction: "import", PeerAS: "AS1103", Filter: "ANY", Action: "pref=100", AFI: "ipv4.unicast"},
+		{Direction: "export", PeerAS: "AS1103", Filter: "AS3333", AFI: "ipv4.unicast"},
+		{Direction: "import", PeerAS: "AS1103", Filter: "ANY", AFI: "ipv6.unicast"},
+		{Direction: "import", PeerAS: "AS12859", Filter: "AS-BIT", AFI: "ipv4.unicast"},
+		{Direction: "export", PeerAS: "AS12859", Filter: "AS3333", AFI: "ipv4.unicast"},
+		{Direction: "import", PeerAS: "AS64500", Filter: "AS64500", AFI: "ipv4.unicast"},
+		{Direction: "export", PeerAS: "AS64500", Filter: "ANY", AFI: "ipv4.unicast"},
+		{Direction: "export", PeerAS: "AS64501", Filter: "AS-RIPENCC", AFI: "any"},
+		{Direction: "default", PeerAS: "AS1103", Filter: "ANY", Action: "pref=100", AFI: "ipv4.unicast"},
+	}, result.AS.Policies)
+
+	assert.Equal(t, []string{"AS1103"}, result.AS.Upstreams)
+	assert.Equal(t, []string{"AS12859", "AS64501"}, result.AS.Peers)
+	assert.Equal(t, []string{"AS64500"}, result.AS.Downstreams)
+	assert.Equal(t, []string{"AS-RIPENCC", "AS-EXAMPLE"}, result.AS.MemberOf)
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// isReverseWhois returns if the whois information is made of domain objects of reverse zone, as returned by RIPE and APNIC,
+// the domain objects returned along with inetnum or inet6num, such as by RIPE with -d, are left to the IP whois
+func isReverseWhois(text string) bool {
+	if rpslIPObjectRx.MatchString(text) {
+		return false
+	}
+
+	class, key := searchRPSLClass(text)
+
+	return class == "domain" && isReverseZone(key)
+}
+
+// isReverseZone returns if the zone is under in-addr.arpa or ip6.arpa
+func isReverseZone(zone string) bool {
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	return strings.HasSuffix(zone, ".in-addr.arpa") || strings.HasSuffix(zone, ".ip6.arpa")
+}
+
+// ParseReverseWhois parses whois information of reverse zone delegations, such as "64.10.99.in-addr.arpa",
+// with contacts attached by reference, a delegation is returned for each domain object of reverse zone
+func ParseReverseWhois(text string) (whoisInfo WhoisInfo, err error) {
+	registry := searchRegistry(text)
+	objects := ParseRPSL(text)
+
+	for _, object := range objects {
+		if object.Class == "domain" && isReverseZone(object.Key()) {
+			resolver := &rpslResolver{objects: objects}
+			whoisInfo.Reverse = append(whoisInfo.Reverse, parseRPSLReverse(object, resolver, registry))
+		}
+	}
+
+	if len(whoisInfo.Reverse) == 0 {
+		err = getDomainErrorType(text)
+	}
+
+	return
+}
+
+// parseRPSLReverse returns reverse delegation of the domain object
+func parseRPSLReverse(object Object, resolver *rpslResolver, registry string) *ReverseDelegation {
+	reverse := &ReverseDelegation{
+		Name:        strings.ToLower(strings.TrimSuffix(object.Key(), ".")),
+		Description: strings.Join(object.GetAll("descr"), "\n"),
+		DSRData:     object.GetAll("ds-rdata"),
+		MntBy:       object.GetAll("mnt-by"),
+		Source:      object.Get("source"),
+		RegDate:     object.Get("created"),
+		Updated:     searchRPSLUpdated(object),
+	}
+
+	reverse.Prefix, _ = parseReverseZone(reverse.Name)
+	reverse.DNSSec = len(reverse.DSRData) > 0
+
+	for _, v := range object.GetAll("nserver") {
+		// name servers may be followed by their glue addresses
+		if fields := strings.Fields(v); len(fields) > 0 {
+			reverse.NameServers = append(reverse.NameServers, strings.ToLower(strings.TrimSuffix(fields[0], ".")))
+		}
+	}
+
+	reverse.Zone = resolver.contacts(object.GetAll("zone-c"))
+	reverse.Administrative = resolver.contacts(object.GetAll("admin-c"))
+	reverse.Technical = resolver.contacts(object.GetAll("tech-c"))
+	reverse.UnresolvedHandles = resolver.unresolved
+
+	reverse.RegDateInTime, reverse.RegDateZoneAssumed, reverse.RegDateApproximate = parseDateTime(reverse.RegDate, registry)
+	reverse.UpdatedInTime, reverse.UpdatedZoneAssumed, reverse.UpdatedApproximate = parseDateTime(reverse.Updated, registry)
+
+	return reverse
+}
+
+// parseReverseZone returns the IP prefix of reverse zone, such as 99.10.64.0/24 of "64.10.99.in-addr.arpa"
+// or 2001:db8::/32 of "8.b.d.0.1.0.0.2.ip6.arpa", the first label of in-addr.arpa zone may be a range
+// such as "0-127" as used for classless delegation, which must make a single prefix
+func parseReverseZone(zone string) (netip.Prefix, bool) {
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+
+	if v, ok := strings.CutSuffix(zone, ".in-addr.arpa"); ok {
+		labels := strings.Split(v, ".")
+		if len(labels) > 4 {
+			return netip.Prefix{}, false
+		}
+
+		var start, end [4]byte
+		for i, label := range labels {
+			k := len(labels) - 1 - i
+			first, last, isRange := strings.Cut(label, "-")
+			if isRange && i != 0 {
+				return netip.Prefix{}, false
+			}
+			if !isRange {
+				last = first
+			}
+			a, err := strconv.ParseUint(first, 10, 8)
+			if err != nil {
+				return netip.Prefix{}, false
+			}
+			b, err := strconv.ParseUint(last, 10, 8)
+			if err != nil || a > b {
+				return netip.Prefix{}, false
+			}
+			start[k], end[k] = byte(a), byte(b)
+		}
+
+		for k := len(labels); k < 4; k++ {
+			end[k] = 0xff
+		}
+
+		prefixes := ipRangePrefixes(netip.AddrFrom4(start), netip.AddrFrom4(end))
+		if len(prefixes) != 1 {
+			return netip.Prefix{}, false
+		}
+
+		return prefixes[0], true
+	}
+
+	if v, ok := strings.CutSuffix(zone, ".ip6.arpa"); ok {
+		labels := strings.Split(v, ".")
+		if len(labels) > 32 {
+			return netip.Prefix{}, false
+		}
+
+		var addr [16]byte
+		for i, label := range labels {
+			nibble, err := strconv.ParseUint(label, 16, 4)
+			if err != nil || len(label) != 1 {
+				return netip.Prefix{}, false
+			}
+			k := len(labels) - 1 - i
+			addr[k/2] |= byte(nibble) << (4 * (1 - k%2))
+		}
+
+		return netip.PrefixFrom(netip.AddrFrom16(addr), 4*len(labels)), true
+	}
+
+	return netip.Prefix{}, false
+}
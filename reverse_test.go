@@ -0,0 +1,147 @@
+package whoisparser
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const ripeReverseWhois = `
+% This is the RIPE Database query service.
+% The objects are in RPSL format.
+
+domain:         64.10.99.in-addr.arpa
+descr:          Example reverse zone
+admin-c:        EX1-RIPE
+tech-c:         EX1-RIPE
+zone-c:         EX1-RIPE
+zone-c:         EX2-RIPE
+nserver:        ns1.example.net
+nserver:        NS2.EXAMPLE.NET. 192.0.2.53
+ds-rdata:       12345 13 2 1f5e2c7e1f8f5d6d4c3b2a19080706050403020100f0e0d0c0b0a09080706050
+mnt-by:         EXAMPLE-MNT
+created:        2015-03-02T10:11:12Z
+last-modified:  2021-06-07T08:09:10Z
+source:         RIPE
+
+person:         Example Person
+address:        Example Street 1
+nic-hdl:        EX1-RIPE
+mnt-by:         EXAMPLE-MNT
+source:         RIPE
+`
+
+// TestParseReverseWhois tests that reverse zone domain objects are parsed with their prefix and DNSSEC data.
+func TestParseReverseWhois(t *testing.T) {
+	assert.True(t, isReverseWhois(ripeReverseWhois))
+
+	result, err := Parse(ripeReverseWhois)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Domain)
+
+	assert.Equal(t, 1, len(result.Reverse))
+	reverse := result.Reverse[0]
+	assert.Equal(t, "64.10.99.in-addr.arpa", reverse.Name)
+	assert.Equal(t, netip.MustParsePrefix("99.10.64.0/24"), reverse.Prefix)
+	assert.Equal(t, "Example reverse zone", reverse.Description)
+	assert.Equal(t, []string{"ns1.example.net", "ns2.example.net"}, reverse.NameServers)
+	assert.True(t, reverse.DNSSec)
+	assert.Equal(t, 1, len(reverse.DSRData))
+	assert.Equal(t, 1, len(reverse.Zone))
+	assert.Equal(t, "EX1-RIPE", reverse.Zone[0].ID)
+	assert.Equal(t, "Example Person", reverse.Administrative[0].Name)
+	assert.Equal(t, 1, len(reverse.Technical))
+	assert.Equal(t, []string{"EXAMPLE-MNT"}, reverse.MntBy)
+	assert.Equal(t, "RIPE", reverse.Source)
+	assert.Equal(t, 2015, reverse.RegDateInTime.Year())
+	assert.Equal(t, 2021, reverse.UpdatedInTime.Year())
+	assert.False(t, reverse.RegDateApproximate)
+	assert.Equal(t, []string{"EX2-RIPE"}, reverse.UnresolvedHandles)
+
+	input := `
+domain:         8.b.d.0.1.0.0.2.ip6.arpa
+nserver:        ns1.example.net
+source:         APNIC
+`
+	result, err = Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, netip.MustParsePrefix("2001:db8::/32"), result.Reverse[0].Prefix)
+	assert.False(t, result.Reverse[0].DNSSec)
+
+	input = `
+domain:         example.com
+nserver:        ns1.example.net
+`
+	assert.False(t, isReverseWhois(input))
+	_, err = ParseReverseWhois(input)
+	assert.NotNil(t, err)
+}
+
+// TestParseReverseWhoisMultiple tests that every reverse delegation is returned,
+// and that reverse delegations along with inetnum are left to the IP whois.
+func TestParseReverseWhoisMultiple(t *testing.T) {
+	input := `
+domain:         64.10.99.in-addr.arpa
+nserver:        ns1.example.net
+source:         RIPE
+
+domain:         65.10.99.in-addr.arpa
+nserver:        ns2.example.net
+source:         RIPE
+`
+	result, err := Parse(input)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(result.Reverse))
+	assert.Equal(t, netip.MustParsePrefix("99.10.64.0/24"), result.Reverse[0].Prefix)
+	assert.Equal(t, netip.MustParsePrefix("99.10.65.0/24"), result.Reverse[1].Prefix)
+	assert.Equal(t, []string{"ns2.example.net"}, result.Reverse[1].NameServers)
+
+	input = `
+inetnum:        99.10.64.0 - 99.10.65.255
+netname:        EXAMPLE-NET
+source:         RIPE
+` + input
+	assert.False(t, isReverseWhois(input))
+
+	result, err = Parse(input)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Reverse)
+	assert.Equal(t, "EXAMPLE-NET", result.IP.Networks[0].Name)
+}
+
+// TestParseReverseZone tests that reverse zones are mapped back to their IP prefix.
+func TestParseReverseZone(t *testing.T) {
+	tests := []struct {
+		zone   string
+		prefix string
+	}{
+		{"64.10.99.in-addr.arpa", "99.10.64.0/24"},
+		{"99.in-addr.arpa.", "99.0.0.0/8"},
+		{"1.64.10.99.IN-ADDR.ARPA", "99.10.64.1/32"},
+		{"0-127.64.10.99.in-addr.arpa", "99.10.64.0/25"},
+		{"64-127.10.99.in-addr.arpa", "99.10.64.0/18"},
+		{"8.b.d.0.1.0.0.2.ip6.arpa", "2001:db8::/32"},
+		{"0.8.b.d.0.1.0.0.2.ip6.arpa", "2001:db8::/36"},
+		{"1.8.b.d.0.1.0.0.2.ip6.arpa", "2001:db8:1000::/36"},
+	}
+
+	for _, v := range tests {
+		prefix, ok := parseReverseZone(v.zone)
+		assert.True(t, ok, v.zone)
+		assert.Equal(t, netip.MustParsePrefix(v.prefix), prefix, v.zone)
+	}
+
+	for _, v := range []string{
+		"0-100.64.10.99.in-addr.arpa",
+		"64.0-127.99.in-addr.arpa",
+		"256.10.99.in-addr.arpa",
+		"1.2.3.4.5.in-addr.arpa",
+		"g.8.b.d.0.1.0.0.2.ip6.arpa",
+		"10.8.b.d.0.1.0.0.2.ip6.arpa",
+		"example.com",
+	} {
+		_, ok := parseReverseZone(v)
+		assert.False(t, ok, v)
+	}
+}
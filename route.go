@@ -0,0 +1,123 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/likexian/gokit/assert"
+)
+
+// OriginConflicts returns the warnings for the networks whose OriginAS disagrees
+// with the origin of all the route objects overlapping them
+func (i *IPInfo) OriginConflicts() []string {
+	var warnings []string
+
+	for _, network := range i.Networks {
+		origins := splitOriginAS(network.OriginAS)
+		if len(origins) == 0 {
+			continue
+		}
+
+		start, end, ok := networkBounds(network)
+		if !ok {
+			continue
+		}
+
+		routes := []string{}
+		matched := false
+		for _, route := range i.Routes {
+			if !routeOverlaps(route, start, end) {
+				continue
+			}
+			routes = append(routes, route.Origin)
+			if assert.IsContains(origins, normalizeOriginAS(route.Origin)) {
+				matched = true
+			}
+		}
+
+		if len(routes) > 0 && !matched {
+			warnings = append(warnings, fmt.Sprintf("origin AS %s of network %s disagrees with route origin %s",
+				network.OriginAS, network.Name, strings.Join(routes, ", ")))
+		}
+	}
+
+	return warnings
+}
+
+// parseRPSLRoutes returns routes of the route and route6 objects, and warnings for malformed prefixes
+func parseRPSLRoutes(objects []Object) ([]*Route, []string) {
+	var routes []*Route
+	var warnings []string
+
+	for _, object := range objects {
+		if object.Class != "route" && object.Class != "route6" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(object.Key())
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("malformed prefix %q of %s object", object.Key(), object.Class))
+			continue
+		}
+
+		routes = append(routes, &Route{
+			Prefix: prefix.Masked(),
+			Origin: normalizeOriginAS(object.Get("origin")),
+			Descr:  strings.Join(object.GetAll("descr"), "\n"),
+			Source: object.Get("source"),
+			MntBy:  object.GetAll("mnt-by"),
+		})
+	}
+
+	return routes, warnings
+}
+
+// routeOverlaps returns if the route prefix overlaps the range from start to end
+func routeOverlaps(route *Route, start, end netip.Addr) bool {
+	first := route.Prefix.Masked().Addr()
+	if first.BitLen() != start.BitLen() {
+		return false
+	}
+
+	return first.Compare(end) <= 0 && lastIPAddr(route.Prefix).Compare(start) >= 0
+}
+
+// splitOriginAS returns the normalized origin AS list, ARIN writes them as "AS7018, AS1234"
+func splitOriginAS(value string) []string {
+	origins := []string{}
+	for _, v := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' }) {
+		origins = append(origins, normalizeOriginAS(v))
+	}
+
+	return origins
+}
+
+// normalizeOriginAS returns the origin AS in upper case with the AS prefix, such as "AS3333"
+func normalizeOriginAS(value string) string {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if value != "" && !strings.HasPrefix(value, "AS") {
+		value = "AS" + value
+	}
+
+	return value
+}
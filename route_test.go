@@ -0,0 +1,87 @@
+package whoisparser
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseIPWhoisRoutes tests that route and route6 objects are parsed into routes.
+func TestParseIPWhoisRoutes(t *testing.T) {
+	input := `% Information related to '193.0.0.0 - 193.0.7.255'
+
+inetnum:        193.0.0.0 - 193.0.7.255
+netname:        RIPE-NCC
+source:         RIPE
+
+% Information related to '193.0.0.0/21AS3333'
+
+route:          193.0.0.0/21
+descr:          RIPE-NCC
+origin:         AS3333
+mnt-by:         RIPE-NCC-MNT
+source:         RIPE
+
+route6:         2001:67c:2e8::/48
+origin:         as3333
+mnt-by:         RIPE-NCC-MNT
+source:         RIPE
+
+route:          193.0.300.0/21
+origin:         AS3333
+source:         RIPE
+`
+	result, err := ParseIPWhois(input)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(result.IP.Routes))
+	assert.Equal(t, &Route{
+		Prefix: netip.MustParsePrefix("193.0.0.0/21"),
+		Origin: "AS3333",
+		Descr:  "RIPE-NCC",
+		Source: "RIPE",
+		MntBy:  []string{"RIPE-NCC-MNT"},
+	}, result.IP.Routes[0])
+	assert.Equal(t, netip.MustParsePrefix("2001:67c:2e8::/48"), result.IP.Routes[1].Prefix)
+	assert.Equal(t, "AS3333", result.IP.Routes[1].Origin)
+	assert.Equal(t, []string{`malformed prefix "193.0.300.0/21" of route object`}, result.IP.Warnings)
+
+	result, err = Parse("route:          193.0.0.0/21\norigin:         AS3333\nsource:         RADB\n")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(result.IP.Networks))
+	assert.Equal(t, "RADB", result.IP.Routes[0].Source)
+
+	result, err = ParseASWhois(`
+aut-num:        AS3333
+as-name:        RIPE-NCC-AS
+source:         RIPE
+
+route:          193.0.0.0/21
+origin:         AS3333
+source:         RIPE
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.AS.Routes))
+	assert.Equal(t, "AS3333", result.AS.Routes[0].Origin)
+}
+
+// TestOriginConflicts tests that networks whose OriginAS disagrees with route objects are flagged.
+func TestOriginConflicts(t *testing.T) {
+	ipInfo := &IPInfo{
+		Networks: []*Network{
+			{Range: "99.10.64.0 - 99.75.191.255", Name: "SBCIS-SBIS", OriginAS: "AS7018, 7132"},
+			{Range: "192.0.2.0 - 192.0.2.255", Name: "EXAMPLE-NET", OriginAS: "AS64500"},
+			{Range: "198.51.100.0 - 198.51.100.255", Name: "NO-ROUTE-NET", OriginAS: "AS64501"},
+			{Range: "203.0.113.0 - 203.0.113.255", Name: "NO-ORIGIN-NET"},
+		},
+		Routes: []*Route{
+			{Prefix: netip.MustParsePrefix("99.74.0.0/16"), Origin: "AS7132"},
+			{Prefix: netip.MustParsePrefix("192.0.0.0/16"), Origin: "AS64496"},
+			{Prefix: netip.MustParsePrefix("192.0.2.0/24"), Origin: "AS64497"},
+			{Prefix: netip.MustParsePrefix("203.0.113.0/24"), Origin: "AS64498"},
+			{Prefix: netip.MustParsePrefix("2001:db8::/32"), Origin: "AS64501"},
+		},
+	}
+
+	assert.Equal(t, []string{"origin AS AS64500 of network EXAMPLE-NET disagrees with route origin AS64496, AS64497"}, ipInfo.OriginConflicts())
+}
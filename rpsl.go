@@ -0,0 +1,507 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/likexian/gokit/assert"
+)
+
+// Object is an object of RPSL whois information, such as inetnum, aut-num or person,
+// as used by RIPE, APNIC, AFRINIC, RADB and some ccTLD registries
+type Object struct {
+	// Class is the name of the first attribute, such as "inetnum"
+	Class string `json:"class"`
+	// Attributes is the attributes in order of appearance, repeated attributes included
+	Attributes []Attribute `json:"attributes"`
+	// Line is the line number of the first attribute in the whois information, starting at 1
+	Line int `json:"line"`
+}
+
+// Attribute is an attribute of RPSL object
+type Attribute struct {
+	// Name is the lower case name of the attribute
+	Name string `json:"name"`
+	// Value is the value of the attribute, continuation lines are joined by newline
+	Value string `json:"value"`
+	// Line is the line number of the attribute in the whois information, starting at 1
+	Line int `json:"line"`
+}
+
+// Key returns the value of the first attribute, which names the object
+func (o Object) Key() string {
+	if len(o.Attributes) == 0 {
+		return ""
+	}
+
+	return o.Attributes[0].Value
+}
+
+// Get returns the value of the first attribute with the name
+func (o Object) Get(name string) string {
+	for _, v := range o.Attributes {
+		if v.Name == name {
+			return v.Value
+		}
+	}
+
+	return ""
+}
+
+// GetAll returns the non-empty values of all the attributes with the name
+func (o Object) GetAll(name string) []string {
+	values := []string{}
+	for _, v := range o.Attributes {
+		if v.Name == name && v.Value != "" {
+			values = append(values, v.Value)
+		}
+	}
+
+	return values
+}
+
+// rpslContactClasses is the classes of objects referenced by contact handles,
+// LACNIC and registro.br contact objects are named after their nic-hdl or nic-hdl-br
+var rpslContactClasses = []string{"person", "role", "nic-hdl", "nic-hdl-br"}
+
+var (
+	rpslAttributeRx = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_-]*):(.*)$`)
+	rpslIPObjectRx  = regexp.MustCompile(`(?m)^inet6?num:`)
+	rpslCommentRx   = regexp.MustCompile(`(^|\s)#.*$`)
+	rpslAbuseRx     = regexp.MustCompile(`(?im)^%\s*Abuse contact for '[^']*' is '([^']+)'`)
+)
+
+// ParseRPSL splits RPSL whois information into objects separated by blank lines.
+// Lines starting with "%" or "#" and end of line comments starting with "#" are stripped,
+// lines starting with whitespace or "+" continue the value of the previous attribute.
+func ParseRPSL(text string) []Object {
+	objects := []Object{}
+	current := Object{}
+
+	text = strings.ReplaceAll(text, "\r", "")
+	for i, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			if len(current.Attributes) > 0 {
+				objects = append(objects, current)
+			}
+			current = Object{}
+			continue
+		}
+
+		if strings.HasPrefix(line, "%") || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if line[0] == ' ' || line[0] == '\t' || line[0] == '+' {
+			if len(current.Attributes) > 0 {
+				attribute := &current.Attributes[len(current.Attributes)-1]
+				value := stripRPSLComment(strings.TrimPrefix(line, "+"))
+				if attribute.Value == "" {
+					attribute.Value = value
+				} else {
+					attribute.Value += "\n" + value
+				}
+			}
+			continue
+		}
+
+		m := rpslAttributeRx.FindStringSubmatch(line)
+		if len(m) == 0 {
+			continue
+		}
+
+		if len(current.Attributes) == 0 {
+			current.Class = strings.ToLower(m[1])
+			current.Line = i + 1
+		}
+
+		current.Attributes = append(current.Attributes, Attribute{
+			Name:  strings.ToLower(m[1]),
+			Value: stripRPSLComment(m[2]),
+			Line:  i + 1,
+		})
+	}
+
+	if len(current.Attributes) > 0 {
+		objects = append(objects, current)
+	}
+
+	return objects
+}
+
+// stripRPSLComment returns the trimmed value without end of line comment
+func stripRPSLComment(value string) string {
+	return strings.TrimSpace(rpslCommentRx.ReplaceAllString(value, ""))
+}
+
+// isRPSLIPWhois returns if the IP whois information is made of RPSL objects, as used by RIPE, APNIC and RADB
+func isRPSLIPWhois(text string) bool {
+	if strings.Contains(text, "NetRange:") {
+		return false
+	}
+
+	for _, object := range ParseRPSL(text) {
+		switch object.Class {
+		case "inetnum", "inet6num", "route", "route6":
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseRPSLIPWhois parses IP whois information made of RPSL objects
+func parseRPSLIPWhois(text string) *IPInfo {
+	ipInfo := &IPInfo{
+		Networks: []*Network{},
+	}
+
+	resolver := &rpslResolver{objects: ParseRPSL(text)}
+	for _, object := range resolver.objects {
+		switch object.Class {
+		case "inetnum", "inet6num":
+			ipInfo.Networks = append(ipInfo.Networks, parseRPSLNetwork(object, resolver))
+		}
+	}
+
+	ipInfo.Routes, ipInfo.Warnings = parseRPSLRoutes(resolver.objects)
+	ipInfo.AbuseComment = searchRPSLAbuseComment(text)
+	ipInfo.UnresolvedHandles = resolver.unresolved
+
+	return ipInfo
+}
+
+// parseRPSLNetwork returns network of the inetnum or inet6num object,
+// with organisation and contacts attached from the referenced objects
+func parseRPSLNetwork(object Object, resolver *rpslResolver) *Network {
+	network := &Network{
+		Range:       object.Key(),
+		Name:        object.Get("netname"),
+		Parent:      object.Get("parent"),
+		Description: strings.Join(object.GetAll("descr"), "\n"),
+		Country:     object.Get("country"),
+		Type:        object.Get("status"),
+		MntBy:       object.GetAll("mnt-by"),
+		RegDate:     object.Get("created"),
+		Updated:     searchRPSLUpdated(object),
+		Comment:     strings.Join(object.GetAll("remarks"), "\n"),
+		Source:      object.Get("source"),
+	}
+
+	if origin := object.Get("aut-num"); origin != "" && !strings.EqualFold(origin, "N/A") {
+		network.OriginAS = normalizeOriginAS(origin)
+	}
+
+	org := resolver.resolve(object.Get("org"), "organisation")
+	if org != nil {
+		network.Organization = parseRPSLContact(*org)
+		network.OrganizationName = formatOrganizationName(network.Organization)
+	} else if owner := parseRPSLOwner(object); owner != nil {
+		network.Organization = owner
+		network.OrganizationName = formatOrganizationName(owner)
+	}
+
+	network.Administrative = resolver.contacts(append(object.GetAll("owner-c"), object.GetAll("admin-c")...))
+	network.Technical = resolver.contacts(object.GetAll("tech-c"))
+	network.Abuse = resolver.abuse(object, org)
+
+	if irt := resolver.resolve(object.Get("mnt-irt"), "irt"); irt != nil {
+		network.IRT = parseRPSLContact(*irt)
+	}
+
+	return network
+}
+
+// formatOrganizationName returns the organization name followed by its ID in parentheses, such as "RIPE Network Coordination Centre (ORG-RIEN1-RIPE)",
+// or the one of them which is not empty
+func formatOrganizationName(org *Contact) string {
+	switch {
+	case org.Organization == "":
+		return org.ID
+	case org.ID == "":
+		return org.Organization
+	}
+
+	return fmt.Sprintf("%s (%s)", org.Organization, org.ID)
+}
+
+// isRPSLASWhois returns if the AS whois information is made of RPSL objects, as used by RIPE and APNIC,
+// the aut-num may follow the as-block containing it as returned by RIPE
+func isRPSLASWhois(text string) bool {
+	if strings.Contains(text, "ASNumber:") {
+		return false
+	}
+
+	for _, v := range searchRPSLClasses(text) {
+		if v[0] == "aut-num" && !strings.EqualFold(v[1], "N/A") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// searchRPSLClass returns the class and key of the first RPSL object of the whois information,
+// which is the queried object, without parsing the whole objects
+func searchRPSLClass(text string) (class, key string) {
+	classes := searchRPSLClasses(text)
+	if len(classes) == 0 {
+		return "", ""
+	}
+
+	return classes[0][0], classes[0][1]
+}
+
+// searchRPSLClasses returns the class and key of every RPSL object of the whois information in order,
+// taken from the first attribute of the objects without parsing the other attributes
+func searchRPSLClasses(text string) [][2]string {
+	var classes [][2]string
+	inObject := false
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			inObject = false
+			continue
+		}
+		if inObject || line[0] == '%' || line[0] == '#' || line[0] == ' ' || line[0] == '\t' || line[0] == '+' {
+			continue
+		}
+		if m := rpslAttributeRx.FindStringSubmatch(line); len(m) > 0 {
+			classes = append(classes, [2]string{strings.ToLower(m[1]), stripRPSLComment(m[2])})
+			inObject = true
+		}
+	}
+
+	return classes
+}
+
+// parseRPSLASWhois parses AS whois information made of RPSL objects
+func parseRPSLASWhois(text string) *ASInfo {
+	asInfo := &ASInfo{}
+
+	resolver := &rpslResolver{objects: ParseRPSL(text)}
+	for _, object := range resolver.objects {
+		if object.Class != "aut-num" {
+			continue
+		}
+
+		key := object.Key()
+		if len(key) > 2 && strings.EqualFold(key[:2], "AS") {
+			key = key[2:]
+		}
+
+		asInfo.Number = key
+		asInfo.Handle = object.Key()
+		asInfo.Name = object.Get("as-name")
+		asInfo.Description = strings.Join(object.GetAll("descr"), "\n")
+		asInfo.MntBy = object.GetAll("mnt-by")
+		asInfo.Source = object.Get("source")
+		asInfo.RegDate = object.Get("created")
+		asInfo.Updated = searchRPSLUpdated(object)
+
+		org := resolver.resolve(object.Get("org"), "organisation")
+		if org != nil {
+			asInfo.Organization = parseRPSLContact(*org)
+		} else {
+			asInfo.Organization = parseRPSLOwner(object)
+		}
+
+		asInfo.AdministrativeContacts = resolver.contacts(append(object.GetAll("owner-c"), object.GetAll("admin-c")...))
+		if len(asInfo.AdministrativeContacts) > 0 {
+			asInfo.Administrative = asInfo.AdministrativeContacts[0]
+		}
+
+		asInfo.TechnicalContacts = resolver.contacts(object.GetAll("tech-c"))
+		if len(asInfo.TechnicalContacts) > 0 {
+			asInfo.Technical = asInfo.TechnicalContacts[0]
+		}
+
+		asInfo.RoutingContacts = resolver.contacts(object.GetAll("routing-c"))
+		if len(asInfo.RoutingContacts) > 0 {
+			asInfo.Routing = asInfo.RoutingContacts[0]
+		}
+
+		asInfo.Abuse = resolver.abuse(object, org)
+
+		asInfo.Policies = parseRPSLPolicies(object)
+		asInfo.Upstreams, asInfo.Peers, asInfo.Downstreams = classifyPolicyPeers(asInfo.Policies)
+		asInfo.MemberOf = parseRPSLMemberOf(object)
+
+		break
+	}
+
+	asInfo.Routes, _ = parseRPSLRoutes(resolver.objects)
+	asInfo.AbuseComment = searchRPSLAbuseComment(text)
+	asInfo.UnresolvedHandles = resolver.unresolved
+
+	return asInfo
+}
+
+// rpslResolver resolves handles to the objects in the same whois information,
+// and records the handles without matching object
+type rpslResolver struct {
+	objects    []Object
+	unresolved []string
+}
+
+// resolve returns the object of the classes referenced by the handle
+func (r *rpslResolver) resolve(handle string, classes ...string) *Object {
+	if handle == "" {
+		return nil
+	}
+
+	object := searchRPSLObject(r.objects, handle, classes...)
+	if object == nil && !assert.IsContains(r.unresolved, handle) {
+		r.unresolved = append(r.unresolved, handle)
+	}
+
+	return object
+}
+
+// contacts returns contacts of the person or role objects referenced by the handles
+func (r *rpslResolver) contacts(handles []string) []*Contact {
+	var contacts []*Contact
+	for _, handle := range handles {
+		if v := r.resolve(handle, rpslContactClasses...); v != nil {
+			contacts = append(contacts, parseRPSLContact(*v))
+		}
+	}
+
+	return contacts
+}
+
+// abuse returns abuse contact referenced by abuse-c of the object, or else of its organisation
+func (r *rpslResolver) abuse(object Object, org *Object) *Contact {
+	handle := object.Get("abuse-c")
+	if handle == "" && org != nil {
+		handle = org.Get("abuse-c")
+	}
+
+	if v := r.resolve(handle, rpslContactClasses...); v != nil {
+		return parseRPSLContact(*v)
+	}
+
+	return nil
+}
+
+// searchRPSLObject returns the object of the classes referenced by the handle,
+// organisation, irt and LACNIC contact objects are referenced by their key and others by nic-hdl
+func searchRPSLObject(objects []Object, handle string, classes ...string) *Object {
+	if handle == "" {
+		return nil
+	}
+
+	for i := range objects {
+		object := objects[i]
+		for _, class := range classes {
+			if object.Class != class {
+				continue
+			}
+			key := object.Get("nic-hdl")
+			switch class {
+			case "organisation", "irt", "nic-hdl", "nic-hdl-br":
+				key = object.Key()
+			}
+			if strings.EqualFold(key, handle) {
+				return &objects[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseRPSLOwner returns organisation contact of the owner attributes of LACNIC and registro.br objects
+func parseRPSLOwner(object Object) *Contact {
+	if object.Get("owner") == "" {
+		return nil
+	}
+
+	return &Contact{
+		ID:           object.Get("ownerid"),
+		Name:         object.Get("responsible"),
+		Organization: object.Get("owner"),
+		Street:       strings.Join(object.GetAll("address"), "\n"),
+		Country:      object.Get("country"),
+		Phone:        object.Get("phone"),
+	}
+}
+
+// searchRPSLUpdated returns the last-modified of the object, or else the date of changed as used by LACNIC,
+// where changed may be written as "hm-changed@apnic.net 20110101" by older databases
+func searchRPSLUpdated(object Object) string {
+	if v := object.Get("last-modified"); v != "" {
+		return v
+	}
+
+	fields := strings.Fields(object.Get("changed"))
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return fields[len(fields)-1]
+}
+
+// parseRPSLContact returns contact of the organisation, person, role or irt object
+func parseRPSLContact(object Object) *Contact {
+	contact := &Contact{
+		ID:               object.Get("nic-hdl"),
+		Street:           strings.Join(object.GetAll("address"), "\n"),
+		Country:          object.Get("country"),
+		Phone:            object.Get("phone"),
+		Fax:              object.Get("fax-no"),
+		Email:            strings.ToLower(object.Get("e-mail")),
+		AbuseMailbox:     strings.ToLower(object.Get("abuse-mailbox")),
+		RegistrationDate: object.Get("created"),
+		Updated:          object.Get("last-modified"),
+		Comment:          strings.Join(object.GetAll("remarks"), "\n"),
+	}
+
+	switch object.Class {
+	case "organisation":
+		contact.ID = object.Key()
+		contact.Organization = object.Get("org-name")
+	case "irt":
+		contact.ID = object.Key()
+		contact.Name = object.Key()
+	case "nic-hdl", "nic-hdl-br":
+		contact.ID = object.Key()
+		contact.Name = object.Get("person")
+		contact.Updated = searchRPSLUpdated(object)
+	default:
+		contact.Name = object.Key()
+	}
+
+	return contact
+}
+
+// searchRPSLAbuseComment returns the email of the first "% Abuse contact for '...' is '...'" comment
+func searchRPSLAbuseComment(text string) string {
+	m := rpslAbuseRx.FindStringSubmatch(text)
+	if len(m) == 0 {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimSpace(m[1]))
+}
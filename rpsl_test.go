@@ -0,0 +1,99 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRPSL(t *testing.T) {
+	input := "% This is the RIPE Database query service.\r\n" + `
+% Information related to 'AS3333'
+
+aut-num:        AS3333
+as-name:        RIPE-NCC-AS
+descr:          Reseaux IP Europeens Network Coordination Centre
+                Amsterdam
++
++               Netherlands
+import:         from AS1299 # main transit
+                accept ANY
+Remarks:        see https://www.ripe.net/#contact
+auth:           # Filtered
+source:         RIPE # Filtered
+
+# end of aut-num
+
+person:         Brian Riddle
+nic-hdl:        BRD-RIPE
+source:         RIPE
+`
+	objects := ParseRPSL(input)
+	assert.Equal(t, 2, len(objects))
+
+	object := objects[0]
+	assert.Equal(t, "aut-num", object.Class)
+	assert.Equal(t, "AS3333", object.Key())
+	assert.Equal(t, 5, object.Line)
+	assert.Equal(t, []Attribute{
+		{Name: "aut-num", Value: "AS3333", Line: 5},
+		{Name: "as-name", Value: "RIPE-NCC-AS", Line: 6},
+		{Name: "descr", Value: "Reseaux IP Europeens Network Coordination Centre\nAmsterdam\n\nNetherlands", Line: 7},
+		{Name: "import", Value: "from AS1299\naccept ANY", Line: 11},
+		{Name: "remarks", Value: "see https://www.ripe.net/#contact", Line: 13},
+		{Name: "auth", Value: "", Line: 14},
+		{Name: "source", Value: "RIPE", Line: 15},
+	}, object.Attributes)
+	assert.Equal(t, "RIPE-NCC-AS", object.Get("as-name"))
+	assert.Equal(t, "", object.Get("org"))
+	assert.Equal(t, []string{}, object.GetAll("auth"))
+
+	object = objects[1]
+	assert.Equal(t, "person", object.Class)
+	assert.Equal(t, "Brian Riddle", object.Key())
+	assert.Equal(t, "BRD-RIPE", object.Get("nic-hdl"))
+	assert.Equal(t, 19, object.Line)
+
+	assert.Equal(t, []Object{}, ParseRPSL("% No entries found\n\n% This query was served by the RIPE Database"))
+}
+
+func TestSearchRPSLClass(t *testing.T) {
+	class, key := searchRPSLClass("% comment\n\naut-num:        AS3333 # RIPE NCC\r\nas-name:        RIPE-NCC-AS\n\nrole: Example\n")
+	assert.Equal(t, "aut-num", class)
+	assert.Equal(t, "AS3333", key)
+
+	class, key = searchRPSLClass("inetnum:     190.0.0.0/22\naut-num:     AS27651\n")
+	assert.Equal(t, "inetnum", class)
+	assert.Equal(t, "190.0.0.0/22", key)
+
+	class, key = searchRPSLClass("% only comments\n")
+	assert.Equal(t, "", class)
+	assert.Equal(t, "", key)
+}
+
+func TestSearchRPSLClasses(t *testing.T) {
+	classes := searchRPSLClasses("% Information related to 'AS3209 - AS3353'\n\nas-block:       AS3209 - AS3353\n" +
+		"descr:          RIPE NCC ASN block\n\n% Information related to 'AS3333'\n\naut-num:        AS3333\n" +
+		"as-name:        RIPE-NCC-AS\n\ninetnum:     190.0.0.0/22\naut-num:     AS27651\n")
+	assert.Equal(t, [][2]string{{"as-block", "AS3209 - AS3353"}, {"aut-num", "AS3333"}, {"inetnum", "190.0.0.0/22"}}, classes)
+	assert.Nil(t, searchRPSLClasses("% only comments\n"))
+}
@@ -0,0 +1,192 @@
+/*
+ * Copyright 2014-2024 Li Kexian
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Go module for domain whois information parsing
+ * https://www.likexian.com/
+ */
+
+package whoisparser
+
+import (
+	"net/netip"
+	"regexp"
+	"strings"
+)
+
+// MemberKind is the kind of as-set or route-set member
+type MemberKind string
+
+const (
+	// MemberKindASN is an AS number member, such as "AS6939"
+	MemberKindASN MemberKind = "asn"
+	// MemberKindSet is an as-set or route-set member, such as "AS-HURRICANE" or "AS6939:RS-EXAMPLE"
+	MemberKindSet MemberKind = "set"
+	// MemberKindPrefix is a prefix member, optionally with range operator, such as "192.0.2.0/24^+"
+	MemberKindPrefix MemberKind = "prefix"
+)
+
+// Member is a member of as-set or route-set object
+type Member struct {
+	Kind  MemberKind `json:"kind"`
+	Value string     `json:"value"`
+}
+
+var memberASNRx = regexp.MustCompile(`(?i)^AS\d+(\.\d+)?$`)
+
+// isRPSLSetWhois returns if the whois information is of as-set, route-set or as-block object,
+// an as-block followed by an aut-num is the block of the queried AS number and left to the AS whois
+func isRPSLSetWhois(text string) bool {
+	classes := searchRPSLClasses(text)
+	if len(classes) == 0 {
+		return false
+	}
+
+	switch classes[0][0] {
+	case "as-set", "route-set":
+		return true
+	case "as-block":
+		for _, v := range classes[1:] {
+			if v[0] == "aut-num" {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// ParseSetWhois parses whois information of as-set, route-set and as-block objects,
+// as returned by IRR and RIR for queries like "AS-HURRICANE" or AS ranges
+func ParseSetWhois(text string) (whoisInfo WhoisInfo, err error) {
+	registry := searchRegistry(text)
+
+	for _, object := range ParseRPSL(text) {
+		switch object.Class {
+		case "as-set":
+			if whoisInfo.ASSet == nil {
+				whoisInfo.ASSet = parseRPSLASSet(object, registry)
+			}
+		case "route-set":
+			if whoisInfo.RouteSet == nil {
+				whoisInfo.RouteSet = parseRPSLRouteSet(object, registry)
+			}
+		case "as-block":
+			if whoisInfo.ASBlock == nil {
+				whoisInfo.ASBlock = parseRPSLASBlock(object, registry)
+			}
+		}
+	}
+
+	if whoisInfo.ASSet == nil && whoisInfo.RouteSet == nil && whoisInfo.ASBlock == nil {
+		err = getASErrorType(text)
+	}
+
+	return
+}
+
+// parseRPSLASSet returns as-set of the object
+func parseRPSLASSet(object Object, registry string) *ASSet {
+	asSet := &ASSet{
+		Name:        object.Key(),
+		Description: strings.Join(object.GetAll("descr"), "\n"),
+		Members:     parseRPSLMembers(object.GetAll("members")),
+		MbrsByRef:   object.GetAll("mbrs-by-ref"),
+		MntBy:       object.GetAll("mnt-by"),
+		Source:      object.Get("source"),
+		RegDate:     object.Get("created"),
+		Updated:     searchRPSLUpdated(object),
+	}
+
+	asSet.RegDateInTime, asSet.RegDateZoneAssumed, asSet.RegDateApproximate = parseDateTime(asSet.RegDate, registry)
+	asSet.UpdatedInTime, asSet.UpdatedZoneAssumed, asSet.UpdatedApproximate = parseDateTime(asSet.Updated, registry)
+
+	return asSet
+}
+
+// parseRPSLRouteSet returns route-set of the object, with members and mp-members together
+func parseRPSLRouteSet(object Object, registry string) *RouteSet {
+	routeSet := &RouteSet{
+		Name:        object.Key(),
+		Description: strings.Join(object.GetAll("descr"), "\n"),
+		Members:     parseRPSLMembers(append(object.GetAll("members"), object.GetAll("mp-members")...)),
+		MbrsByRef:   object.GetAll("mbrs-by-ref"),
+		MntBy:       object.GetAll("mnt-by"),
+		Source:      object.Get("source"),
+		RegDate:     object.Get("created"),
+		Updated:     searchRPSLUpdated(object),
+	}
+
+	routeSet.RegDateInTime, routeSet.RegDateZoneAssumed, routeSet.RegDateApproximate = parseDateTime(routeSet.RegDate, registry)
+	routeSet.UpdatedInTime, routeSet.UpdatedZoneAssumed, routeSet.UpdatedApproximate = parseDateTime(routeSet.Updated, registry)
+
+	return routeSet
+}
+
+// parseRPSLASBlock returns as-block of the object, the range is written as "AS3154 - AS3353"
+func parseRPSLASBlock(object Object, registry string) *ASBlock {
+	asBlock := &ASBlock{
+		Range:       object.Key(),
+		Description: strings.Join(object.GetAll("descr"), "\n"),
+		MntBy:       object.GetAll("mnt-by"),
+		Source:      object.Get("source"),
+		RegDate:     object.Get("created"),
+		Updated:     searchRPSLUpdated(object),
+	}
+
+	// the range is left as zero if malformed
+	asBlock.Start, asBlock.End, _ = ParseASNRange(asBlock.Range)
+
+	if org := object.Get("org"); org != "" {
+		asBlock.Organization = &Contact{ID: org}
+	}
+
+	asBlock.RegDateInTime, asBlock.RegDateZoneAssumed, asBlock.RegDateApproximate = parseDateTime(asBlock.RegDate, registry)
+	asBlock.UpdatedInTime, asBlock.UpdatedZoneAssumed, asBlock.UpdatedApproximate = parseDateTime(asBlock.Updated, registry)
+
+	return asBlock
+}
+
+// parseRPSLMembers returns typed members of the values, separated by comma or whitespace
+func parseRPSLMembers(values []string) []Member {
+	var members []Member
+	for _, value := range values {
+		for _, v := range strings.FieldsFunc(value, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t' || r == '\n'
+		}) {
+			members = append(members, parseRPSLMember(v))
+		}
+	}
+
+	return members
+}
+
+// parseRPSLMember returns typed member of the value
+func parseRPSLMember(value string) Member {
+	if memberASNRx.MatchString(value) {
+		return Member{Kind: MemberKindASN, Value: strings.ToUpper(value)}
+	}
+
+	prefix := value
+	if pos := strings.Index(prefix, "^"); pos != -1 {
+		prefix = prefix[:pos]
+	}
+
+	if _, err := netip.ParsePrefix(prefix); err == nil {
+		return Member{Kind: MemberKindPrefix, Value: value}
+	}
+
+	return Member{Kind: MemberKindSet, Value: strings.ToUpper(value)}
+}
@@ -0,0 +1,89 @@
+package whoisparser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseSetWhois tests parsing of as-set, route-set and as-block objects.
+func TestParseSetWhois(t *testing.T) {
+	result, err := Parse(`
+% This is the RIPE Database query service.
+
+as-set:         AS-HURRICANE
+descr:          Hurricane Electric
+members:        AS6939, AS-HURRICANEV4,
+                as-tpg
+members:        AS1:AS-CUSTOMERS AS4200000000
+mbrs-by-ref:    MAINT-AS6939
+mnt-by:         MAINT-AS6939
+created:        2003-10-15T17:18:55Z
+last-modified:  2024-02-13T19:00:20Z
+source:         RIPE
+`)
+	assert.Nil(t, err)
+	assert.Nil(t, result.AS)
+	assert.Equal(t, "AS-HURRICANE", result.ASSet.Name)
+	assert.Equal(t, []Member{
+		{Kind: MemberKindASN, Value: "AS6939"},
+		{Kind: MemberKindSet, Value: "AS-HURRICANEV4"},
+		{Kind: MemberKindSet, Value: "AS-TPG"},
+		{Kind: MemberKindSet, Value: "AS1:AS-CUSTOMERS"},
+		{Kind: MemberKindASN, Value: "AS4200000000"},
+	}, result.ASSet.Members)
+	assert.Equal(t, []string{"MAINT-AS6939"}, result.ASSet.MbrsByRef)
+	assert.Equal(t, "RIPE", result.ASSet.Source)
+	assert.Equal(t, "2024-02-13T19:00:20Z", result.ASSet.UpdatedInTime.Format(time.RFC3339))
+	assert.False(t, result.ASSet.UpdatedApproximate)
+
+	result, err = Parse(`
+route-set:      RS-EXAMPLE
+descr:          Example prefixes
+members:        192.0.2.0/24, 198.51.100.0/22^+
+mp-members:     2001:db8::/32^48, RS-OTHER, AS64500
+mnt-by:         MAINT-EXAMPLE
+source:         RADB
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, "RS-EXAMPLE", result.RouteSet.Name)
+	assert.Equal(t, []Member{
+		{Kind: MemberKindPrefix, Value: "192.0.2.0/24"},
+		{Kind: MemberKindPrefix, Value: "198.51.100.0/22^+"},
+		{Kind: MemberKindPrefix, Value: "2001:db8::/32^48"},
+		{Kind: MemberKindSet, Value: "RS-OTHER"},
+		{Kind: MemberKindASN, Value: "AS64500"},
+	}, result.RouteSet.Members)
+
+	result, err = Parse(`
+as-block:       AS3154 - AS3353
+descr:          RIPE NCC ASN block
+org:            ORG-NCC1-RIPE
+mnt-by:         RIPE-NCC-HM-MNT
+created:        2018-11-22T15:27:19Z
+last-modified:  2018-11-22T15:27:19Z
+source:         RIPE
+`)
+	assert.Nil(t, err)
+	assert.Equal(t, "AS3154 - AS3353", result.ASBlock.Range)
+	assert.Equal(t, ASN(3154), result.ASBlock.Start)
+	assert.Equal(t, ASN(3353), result.ASBlock.End)
+	assert.Equal(t, "ORG-NCC1-RIPE", result.ASBlock.Organization.ID)
+	assert.Equal(t, "2018-11-22T15:27:19Z", result.ASBlock.RegDateInTime.Format(time.RFC3339))
+	assert.False(t, result.ASBlock.RegDateApproximate)
+
+	_, err = ParseSetWhois("% No entries found for the selected source(s).\n")
+	assert.Equal(t, ErrNotFoundAS, err)
+}
+
+// TestIsRPSLSetWhois tests that an as-block followed by the queried aut-num is not taken as set whois.
+func TestIsRPSLSetWhois(t *testing.T) {
+	asBlock := "% Information related to 'AS3209 - AS3353'\n\nas-block:       AS3209 - AS3353\nsource:         RIPE\n"
+	autNum := "\n% Information related to 'AS3333'\n\naut-num:        AS3333\nas-name:        RIPE-NCC-AS\nsource:         RIPE\n"
+
+	assert.True(t, isRPSLSetWhois(asBlock))
+	assert.False(t, isRPSLSetWhois(asBlock+autNum))
+	assert.True(t, isRPSLSetWhois("as-set:         AS-HURRICANE\nmembers:        AS6939\n"))
+	assert.False(t, isRPSLSetWhois(autNum))
+}
@@ -19,96 +19,324 @@
 
 package whoisparser
 
-import "time"
+import (
+	"net/netip"
+	"time"
+)
 
 // WhoisInfo stores domain, IP, or AS WHOIS information.
 type WhoisInfo struct {
-	Domain         *Domain  `json:"domain,omitempty"`
-	Registrar      *Contact `json:"registrar,omitempty"`
-	Registrant     *Contact `json:"registrant,omitempty"`
-	Administrative *Contact `json:"administrative,omitempty"`
-	Technical      *Contact `json:"technical,omitempty"`
-	Billing        *Contact `json:"billing,omitempty"`
-	IP             *IPInfo  `json:"ip,omitempty"`
-	AS             *ASInfo  `json:"as,omitempty"`
+	Domain         *Domain              `json:"domain,omitempty"`
+	Registrar      *Contact             `json:"registrar,omitempty"`
+	Registrant     *Contact             `json:"registrant,omitempty"`
+	Administrative *Contact             `json:"administrative,omitempty"`
+	Technical      *Contact             `json:"technical,omitempty"`
+	Billing        *Contact             `json:"billing,omitempty"`
+	IP             *IPInfo              `json:"ip,omitempty"`
+	AS             *ASInfo              `json:"as,omitempty"`
+	ASSet          *ASSet               `json:"as_set,omitempty"`
+	RouteSet       *RouteSet            `json:"route_set,omitempty"`
+	ASBlock        *ASBlock             `json:"as_block,omitempty"`
+	Cymru          []*CymruRecord       `json:"cymru,omitempty"`
+	Org            *OrgInfo             `json:"org,omitempty"`
+	POC            *POCInfo             `json:"poc,omitempty"`
+	Reverse        []*ReverseDelegation `json:"reverse,omitempty"`
 }
 
 // Domain stores domain name information.
 type Domain struct {
-	ID                   string     `json:"id,omitempty"`
-	Domain               string     `json:"domain,omitempty"`
-	Punycode             string     `json:"punycode,omitempty"`
-	Name                 string     `json:"name,omitempty"`
-	Extension            string     `json:"extension,omitempty"`
-	WhoisServer          string     `json:"whois_server,omitempty"`
-	Status               []string   `json:"status,omitempty"`
-	NameServers          []string   `json:"name_servers,omitempty"`
-	DNSSec               bool       `json:"dnssec,omitempty"`
-	CreatedDate          string     `json:"created_date,omitempty"`
-	CreatedDateInTime    *time.Time `json:"created_date_in_time,omitempty"`
-	UpdatedDate          string     `json:"updated_date,omitempty"`
-	UpdatedDateInTime    *time.Time `json:"updated_date_in_time,omitempty"`
-	ExpirationDate       string     `json:"expiration_date,omitempty"`
-	ExpirationDateInTime *time.Time `json:"expiration_date_in_time,omitempty"`
+	ID                        string     `json:"id,omitempty"`
+	Domain                    string     `json:"domain,omitempty"`
+	Punycode                  string     `json:"punycode,omitempty"`
+	Name                      string     `json:"name,omitempty"`
+	Extension                 string     `json:"extension,omitempty"`
+	WhoisServer               string     `json:"whois_server,omitempty"`
+	Status                    []string   `json:"status,omitempty"`
+	NameServers               []string   `json:"name_servers,omitempty"`
+	DNSSec                    bool       `json:"dnssec,omitempty"`
+	CreatedDate               string     `json:"created_date,omitempty"`
+	CreatedDateInTime         *time.Time `json:"created_date_in_time,omitempty"`
+	CreatedDateZoneAssumed    bool       `json:"created_date_zone_assumed,omitempty"`
+	CreatedDateApproximate    bool       `json:"created_date_approximate,omitempty"`
+	UpdatedDate               string     `json:"updated_date,omitempty"`
+	UpdatedDateInTime         *time.Time `json:"updated_date_in_time,omitempty"`
+	UpdatedDateZoneAssumed    bool       `json:"updated_date_zone_assumed,omitempty"`
+	UpdatedDateApproximate    bool       `json:"updated_date_approximate,omitempty"`
+	ExpirationDate            string     `json:"expiration_date,omitempty"`
+	ExpirationDateInTime      *time.Time `json:"expiration_date_in_time,omitempty"`
+	ExpirationDateZoneAssumed bool       `json:"expiration_date_zone_assumed,omitempty"`
+	ExpirationDateApproximate bool       `json:"expiration_date_approximate,omitempty"`
 }
 
 // Contact stores contact information.
 type Contact struct {
-	ID               string `json:"id,omitempty"`
-	Name             string `json:"name,omitempty"`
-	Organization     string `json:"organization,omitempty"`
-	Street           string `json:"street,omitempty"`
-	City             string `json:"city,omitempty"`
-	Province         string `json:"province,omitempty"`
-	PostalCode       string `json:"postal_code,omitempty"`
-	Country          string `json:"country,omitempty"`
-	Phone            string `json:"phone,omitempty"`
-	PhoneExt         string `json:"phone_ext,omitempty"`
-	Fax              string `json:"fax,omitempty"`
-	FaxExt           string `json:"fax_ext,omitempty"`
-	Email            string `json:"email,omitempty"`
-	ReferralURL      string `json:"referral_url,omitempty"`
-	RegistrationDate string `json:"registration_date,omitempty"`
-	Updated          string `json:"updated,omitempty"`
-	Comment          string `json:"comment,omitempty"`
+	ID                          string     `json:"id,omitempty"`
+	Name                        string     `json:"name,omitempty"`
+	Organization                string     `json:"organization,omitempty"`
+	Street                      string     `json:"street,omitempty"`
+	City                        string     `json:"city,omitempty"`
+	Province                    string     `json:"province,omitempty"`
+	PostalCode                  string     `json:"postal_code,omitempty"`
+	Country                     string     `json:"country,omitempty"`
+	Phone                       string     `json:"phone,omitempty"`
+	PhoneExt                    string     `json:"phone_ext,omitempty"`
+	Fax                         string     `json:"fax,omitempty"`
+	FaxExt                      string     `json:"fax_ext,omitempty"`
+	Email                       string     `json:"email,omitempty"`
+	AbuseMailbox                string     `json:"abuse_mailbox,omitempty"`
+	ReferralURL                 string     `json:"referral_url,omitempty"`
+	RegistrationDate            string     `json:"registration_date,omitempty"`
+	RegistrationDateInTime      *time.Time `json:"registration_date_in_time,omitempty"`
+	RegistrationDateZoneAssumed bool       `json:"registration_date_zone_assumed,omitempty"`
+	RegistrationDateApproximate bool       `json:"registration_date_approximate,omitempty"`
+	Updated                     string     `json:"updated,omitempty"`
+	UpdatedInTime               *time.Time `json:"updated_in_time,omitempty"`
+	UpdatedZoneAssumed          bool       `json:"updated_zone_assumed,omitempty"`
+	UpdatedApproximate          bool       `json:"updated_approximate,omitempty"`
+	Comment                     string     `json:"comment,omitempty"`
 }
 
 // IPInfo stores IP WHOIS information.
 type IPInfo struct {
-	Networks  []*Network `json:"networks,omitempty"`
-	Abuse     *Contact   `json:"abuse,omitempty"`
-	Technical *Contact   `json:"technical,omitempty"`
-	Routing   *Contact   `json:"routing,omitempty"`
+	Networks          []*Network `json:"networks,omitempty"`
+	Abuse             *Contact   `json:"abuse,omitempty"`
+	Technical         *Contact   `json:"technical,omitempty"`
+	Routing           *Contact   `json:"routing,omitempty"`
+	AbuseComment      string     `json:"abuse_comment,omitempty"`
+	Routes            []*Route   `json:"routes,omitempty"`
+	Matches           []*Match   `json:"matches,omitempty"`
+	UnresolvedHandles []string   `json:"unresolved_handles,omitempty"`
+	Warnings          []string   `json:"warnings,omitempty"`
+}
+
+// Match stores a network of summary list, to be queried by its handle for the full record.
+type Match struct {
+	Handle       string     `json:"handle,omitempty"`
+	Name         string     `json:"name,omitempty"`
+	Organization string     `json:"organization,omitempty"`
+	Range        string     `json:"range,omitempty"`
+	Start        netip.Addr `json:"start"`
+	End          netip.Addr `json:"end"`
 }
 
 // Network stores IP network information.
 type Network struct {
-	Range            string   `json:"range,omitempty"`
-	CIDR             []string `json:"cidr,omitempty"`
-	Name             string   `json:"name,omitempty"`
-	Handle           string   `json:"handle,omitempty"`
-	Parent           string   `json:"parent,omitempty"`
-	Type             string   `json:"type,omitempty"`
-	OriginAS         string   `json:"origin_as,omitempty"`
-	OrganizationName string   `json:"organization_name,omitempty"` // Add this line
-	Organization     *Contact `json:"organization,omitempty"`
-	Customer         *Contact `json:"customer,omitempty"`
-	RegDate          string   `json:"reg_date,omitempty"`
-	Updated          string   `json:"updated,omitempty"`
-	Comment          string   `json:"comment,omitempty"`
-	Ref              string   `json:"ref,omitempty"`
+	Range              string           `json:"range,omitempty"`
+	CIDR               []string         `json:"cidr,omitempty"`
+	Start              netip.Addr       `json:"start"`
+	End                netip.Addr       `json:"end"`
+	Prefixes           []netip.Prefix   `json:"prefixes,omitempty"`
+	Name               string           `json:"name,omitempty"`
+	Handle             string           `json:"handle,omitempty"`
+	Parent             string           `json:"parent,omitempty"`
+	Type               string           `json:"type,omitempty"`
+	Status             AllocationStatus `json:"status,omitempty"`
+	Portable           *bool            `json:"portable,omitempty"`
+	OriginAS           string           `json:"origin_as,omitempty"`
+	OrganizationName   string           `json:"organization_name,omitempty"` // Add this line
+	Organization       *Contact         `json:"organization,omitempty"`
+	Customer           *Contact         `json:"customer,omitempty"`
+	Administrative     []*Contact       `json:"administrative,omitempty"`
+	Technical          []*Contact       `json:"technical,omitempty"`
+	Abuse              *Contact         `json:"abuse,omitempty"`
+	IRT                *Contact         `json:"irt,omitempty"`
+	Description        string           `json:"description,omitempty"`
+	Country            string           `json:"country,omitempty"`
+	MntBy              []string         `json:"mnt_by,omitempty"`
+	Source             string           `json:"source,omitempty"`
+	RegDate            string           `json:"reg_date,omitempty"`
+	RegDateInTime      *time.Time       `json:"reg_date_in_time,omitempty"`
+	RegDateZoneAssumed bool             `json:"reg_date_zone_assumed,omitempty"`
+	RegDateApproximate bool             `json:"reg_date_approximate,omitempty"`
+	Updated            string           `json:"updated,omitempty"`
+	UpdatedInTime      *time.Time       `json:"updated_in_time,omitempty"`
+	UpdatedZoneAssumed bool             `json:"updated_zone_assumed,omitempty"`
+	UpdatedApproximate bool             `json:"updated_approximate,omitempty"`
+	Comment            string           `json:"comment,omitempty"`
+	Ref                string           `json:"ref,omitempty"`
 }
 
-// ASInfo stores AS WHOIS information.
+// ASInfo stores AS WHOIS information, Administrative, Routing and Technical are the first
+// of the contacts of their role when a registry gives several of them.
 type ASInfo struct {
-	Number       string   `json:"number,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Handle       string   `json:"handle,omitempty"`
-	RegDate      string   `json:"reg_date,omitempty"`
-	Updated      string   `json:"updated,omitempty"`
-	Ref          string   `json:"ref,omitempty"`
-	Organization *Contact `json:"organization,omitempty"`
-	Routing      *Contact `json:"routing,omitempty"`
-	Technical    *Contact `json:"technical,omitempty"`
-	Abuse        *Contact `json:"abuse,omitempty"`
+	Number                 string     `json:"number,omitempty"`
+	Start                  ASN        `json:"start,omitempty"`
+	End                    ASN        `json:"end,omitempty"`
+	Name                   string     `json:"name,omitempty"`
+	Handle                 string     `json:"handle,omitempty"`
+	RegDate                string     `json:"reg_date,omitempty"`
+	RegDateInTime          *time.Time `json:"reg_date_in_time,omitempty"`
+	RegDateZoneAssumed     bool       `json:"reg_date_zone_assumed,omitempty"`
+	RegDateApproximate     bool       `json:"reg_date_approximate,omitempty"`
+	Updated                string     `json:"updated,omitempty"`
+	UpdatedInTime          *time.Time `json:"updated_in_time,omitempty"`
+	UpdatedZoneAssumed     bool       `json:"updated_zone_assumed,omitempty"`
+	UpdatedApproximate     bool       `json:"updated_approximate,omitempty"`
+	Ref                    string     `json:"ref,omitempty"`
+	Description            string     `json:"description,omitempty"`
+	MntBy                  []string   `json:"mnt_by,omitempty"`
+	Source                 string     `json:"source,omitempty"`
+	Registry               string     `json:"registry,omitempty"`
+	Organization           *Contact   `json:"organization,omitempty"`
+	Administrative         *Contact   `json:"administrative,omitempty"`
+	Routing                *Contact   `json:"routing,omitempty"`
+	Technical              *Contact   `json:"technical,omitempty"`
+	Abuse                  *Contact   `json:"abuse,omitempty"`
+	AdministrativeContacts []*Contact `json:"administrative_contacts,omitempty"`
+	RoutingContacts        []*Contact `json:"routing_contacts,omitempty"`
+	TechnicalContacts      []*Contact `json:"technical_contacts,omitempty"`
+	AbuseComment           string     `json:"abuse_comment,omitempty"`
+	Routes                 []*Route   `json:"routes,omitempty"`
+	Policies               []Policy   `json:"policies,omitempty"`
+	Upstreams              []string   `json:"upstreams,omitempty"`
+	Peers                  []string   `json:"peers,omitempty"`
+	Downstreams            []string   `json:"downstreams,omitempty"`
+	MemberOf               []string   `json:"member_of,omitempty"`
+	UnresolvedHandles      []string   `json:"unresolved_handles,omitempty"`
+}
+
+// Route stores route or route6 object information.
+type Route struct {
+	Prefix netip.Prefix `json:"prefix"`
+	Origin string       `json:"origin,omitempty"`
+	Descr  string       `json:"descr,omitempty"`
+	Source string       `json:"source,omitempty"`
+	MntBy  []string     `json:"mnt_by,omitempty"`
+}
+
+// OrgInfo stores ARIN organization information.
+type OrgInfo struct {
+	Handle             string     `json:"handle,omitempty"`
+	Name               string     `json:"name,omitempty"`
+	Street             string     `json:"street,omitempty"`
+	City               string     `json:"city,omitempty"`
+	Province           string     `json:"province,omitempty"`
+	PostalCode         string     `json:"postal_code,omitempty"`
+	Country            string     `json:"country,omitempty"`
+	RegDate            string     `json:"reg_date,omitempty"`
+	RegDateInTime      *time.Time `json:"reg_date_in_time,omitempty"`
+	RegDateZoneAssumed bool       `json:"reg_date_zone_assumed,omitempty"`
+	RegDateApproximate bool       `json:"reg_date_approximate,omitempty"`
+	Updated            string     `json:"updated,omitempty"`
+	UpdatedInTime      *time.Time `json:"updated_in_time,omitempty"`
+	UpdatedZoneAssumed bool       `json:"updated_zone_assumed,omitempty"`
+	UpdatedApproximate bool       `json:"updated_approximate,omitempty"`
+	Comment            string     `json:"comment,omitempty"`
+	Ref                string     `json:"ref,omitempty"`
+	Abuse              []*Contact `json:"abuse,omitempty"`
+	Administrative     []*Contact `json:"administrative,omitempty"`
+	Technical          []*Contact `json:"technical,omitempty"`
+	NOC                []*Contact `json:"noc,omitempty"`
+	Routing            []*Contact `json:"routing,omitempty"`
+	DNS                []*Contact `json:"dns,omitempty"`
+}
+
+// POCInfo stores ARIN point of contact information.
+type POCInfo struct {
+	Handle             string     `json:"handle,omitempty"`
+	Name               string     `json:"name,omitempty"`
+	Company            string     `json:"company,omitempty"`
+	Street             string     `json:"street,omitempty"`
+	City               string     `json:"city,omitempty"`
+	Province           string     `json:"province,omitempty"`
+	PostalCode         string     `json:"postal_code,omitempty"`
+	Country            string     `json:"country,omitempty"`
+	Phones             []string   `json:"phones,omitempty"`
+	Emails             []string   `json:"emails,omitempty"`
+	RegDate            string     `json:"reg_date,omitempty"`
+	RegDateInTime      *time.Time `json:"reg_date_in_time,omitempty"`
+	RegDateZoneAssumed bool       `json:"reg_date_zone_assumed,omitempty"`
+	RegDateApproximate bool       `json:"reg_date_approximate,omitempty"`
+	Updated            string     `json:"updated,omitempty"`
+	UpdatedInTime      *time.Time `json:"updated_in_time,omitempty"`
+	UpdatedZoneAssumed bool       `json:"updated_zone_assumed,omitempty"`
+	UpdatedApproximate bool       `json:"updated_approximate,omitempty"`
+	Comment            string     `json:"comment,omitempty"`
+	Ref                string     `json:"ref,omitempty"`
+}
+
+// ReverseDelegation stores reverse zone domain object information, Zone is the contacts of zone-c.
+type ReverseDelegation struct {
+	Name               string       `json:"name,omitempty"`
+	Prefix             netip.Prefix `json:"prefix"`
+	Description        string       `json:"description,omitempty"`
+	NameServers        []string     `json:"name_servers,omitempty"`
+	DNSSec             bool         `json:"dnssec,omitempty"`
+	DSRData            []string     `json:"ds_rdata,omitempty"`
+	Zone               []*Contact   `json:"zone,omitempty"`
+	Administrative     []*Contact   `json:"administrative,omitempty"`
+	Technical          []*Contact   `json:"technical,omitempty"`
+	MntBy              []string     `json:"mnt_by,omitempty"`
+	Source             string       `json:"source,omitempty"`
+	RegDate            string       `json:"reg_date,omitempty"`
+	RegDateInTime      *time.Time   `json:"reg_date_in_time,omitempty"`
+	RegDateZoneAssumed bool         `json:"reg_date_zone_assumed,omitempty"`
+	RegDateApproximate bool         `json:"reg_date_approximate,omitempty"`
+	Updated            string       `json:"updated,omitempty"`
+	UpdatedInTime      *time.Time   `json:"updated_in_time,omitempty"`
+	UpdatedZoneAssumed bool         `json:"updated_zone_assumed,omitempty"`
+	UpdatedApproximate bool         `json:"updated_approximate,omitempty"`
+	UnresolvedHandles  []string     `json:"unresolved_handles,omitempty"`
+}
+
+// CymruRecord stores Team Cymru IP to ASN mapping of a query.
+type CymruRecord struct {
+	Query string  `json:"query,omitempty"`
+	IP    *IPInfo `json:"ip,omitempty"`
+	AS    *ASInfo `json:"as,omitempty"`
+}
+
+// ASSet stores as-set object information.
+type ASSet struct {
+	Name               string     `json:"name,omitempty"`
+	Description        string     `json:"description,omitempty"`
+	Members            []Member   `json:"members,omitempty"`
+	MbrsByRef          []string   `json:"mbrs_by_ref,omitempty"`
+	MntBy              []string   `json:"mnt_by,omitempty"`
+	Source             string     `json:"source,omitempty"`
+	RegDate            string     `json:"reg_date,omitempty"`
+	RegDateInTime      *time.Time `json:"reg_date_in_time,omitempty"`
+	RegDateZoneAssumed bool       `json:"reg_date_zone_assumed,omitempty"`
+	RegDateApproximate bool       `json:"reg_date_approximate,omitempty"`
+	Updated            string     `json:"updated,omitempty"`
+	UpdatedInTime      *time.Time `json:"updated_in_time,omitempty"`
+	UpdatedZoneAssumed bool       `json:"updated_zone_assumed,omitempty"`
+	UpdatedApproximate bool       `json:"updated_approximate,omitempty"`
+}
+
+// RouteSet stores route-set object information.
+type RouteSet struct {
+	Name               string     `json:"name,omitempty"`
+	Description        string     `json:"description,omitempty"`
+	Members            []Member   `json:"members,omitempty"`
+	MbrsByRef          []string   `json:"mbrs_by_ref,omitempty"`
+	MntBy              []string   `json:"mnt_by,omitempty"`
+	Source             string     `json:"source,omitempty"`
+	RegDate            string     `json:"reg_date,omitempty"`
+	RegDateInTime      *time.Time `json:"reg_date_in_time,omitempty"`
+	RegDateZoneAssumed bool       `json:"reg_date_zone_assumed,omitempty"`
+	RegDateApproximate bool       `json:"reg_date_approximate,omitempty"`
+	Updated            string     `json:"updated,omitempty"`
+	UpdatedInTime      *time.Time `json:"updated_in_time,omitempty"`
+	UpdatedZoneAssumed bool       `json:"updated_zone_assumed,omitempty"`
+	UpdatedApproximate bool       `json:"updated_approximate,omitempty"`
+}
+
+// ASBlock stores as-block object information.
+type ASBlock struct {
+	Range              string     `json:"range,omitempty"`
+	Start              ASN        `json:"start,omitempty"`
+	End                ASN        `json:"end,omitempty"`
+	Description        string     `json:"description,omitempty"`
+	Organization       *Contact   `json:"organization,omitempty"`
+	MntBy              []string   `json:"mnt_by,omitempty"`
+	Source             string     `json:"source,omitempty"`
+	RegDate            string     `json:"reg_date,omitempty"`
+	RegDateInTime      *time.Time `json:"reg_date_in_time,omitempty"`
+	RegDateZoneAssumed bool       `json:"reg_date_zone_assumed,omitempty"`
+	RegDateApproximate bool       `json:"reg_date_approximate,omitempty"`
+	Updated            string     `json:"updated,omitempty"`
+	UpdatedInTime      *time.Time `json:"updated_in_time,omitempty"`
+	UpdatedZoneAssumed bool       `json:"updated_zone_assumed,omitempty"`
+	UpdatedApproximate bool       `json:"updated_approximate,omitempty"`
 }
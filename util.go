@@ -20,10 +20,10 @@
 package whoisparser
 
 import (
-	"fmt"
+	"net/netip"
+	"regexp"
 	"sort"
 	"strings"
-	"time"
 )
 
 // isDNSSecEnabled returns if domain dnssec is enabled
@@ -102,78 +102,109 @@ func containsIn(data string, substrs []string) bool {
 	return false
 }
 
-// Keys returns all keys of map by sort
-func keys(m map[string]string) []string {
-	r := []string{}
+// ipv4AbbreviatedPrefixRx matches IPv4 prefix written with less than four octets, such as "200.7.84/23"
+var ipv4AbbreviatedPrefixRx = regexp.MustCompile(`^\d{1,3}(\.\d{1,3}){0,2}/\d{1,2}$`)
 
-	for k := range m {
-		r = append(r, k)
+// expandIPv4Prefix returns the IPv4 prefix with its missing octets filled with zero,
+// such as "200.7.84.0/23" of "200.7.84/23" as abbreviated by LACNIC, other values are returned as is
+func expandIPv4Prefix(value string) string {
+	value = strings.TrimSpace(value)
+	if !ipv4AbbreviatedPrefixRx.MatchString(value) {
+		return value
 	}
 
-	sort.Strings(r)
+	addr, bits, _ := strings.Cut(value, "/")
+	for strings.Count(addr, ".") < 3 {
+		addr += ".0"
+	}
 
-	return r
+	return addr + "/" + bits
+}
+
+// parseIPRange returns the first and last address of an IP range,
+// the range may be written as "start - end" or as a CIDR prefix
+func parseIPRange(value string) (start, end netip.Addr, ok bool) {
+	value = strings.TrimSpace(value)
+
+	if strings.Contains(value, "-") {
+		var err error
+		vs := strings.SplitN(value, "-", 2)
+		if start, err = netip.ParseAddr(strings.TrimSpace(vs[0])); err != nil {
+			return start, end, false
+		}
+		if end, err = netip.ParseAddr(strings.TrimSpace(vs[1])); err != nil {
+			return start, end, false
+		}
+		return start, end, start.BitLen() == end.BitLen() && start.Compare(end) <= 0
+	}
+
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return start, end, false
+	}
+
+	prefix = prefix.Masked()
+
+	return prefix.Addr(), lastIPAddr(prefix), true
 }
 
-// parseDateString attempts to parse a given date using a collection of common
-// format strings. Date formats containing time components are tried first
-// before attempts are made using date-only formats.
-func parseDateString(datetime string) (time.Time, error) {
-	datetime = strings.Trim(datetime, ".")
-	datetime = strings.ReplaceAll(datetime, ". ", "-")
-
-	formats := [...]string{
-		// Date & time formats
-		"2006-01-02 15:04:05",
-		"2006.01.02 15:04:05",
-		"02/01/2006 15:04:05",
-		"02.01.2006 15:04:05",
-		"02.1.2006 15:04:05",
-		"2.1.2006 15:04:05",
-		"02-Jan-2006 15:04:05",
-		"20060102 15:04:05",
-		time.ANSIC,
-		time.Stamp,
-		time.StampMilli,
-		time.StampMicro,
-		time.StampNano,
-
-		// Date, time & time zone formats
-		"2006-01-02T15:04:05Z",
-		"2006-01-02 15:04:05-07",
-		"2006-01-02 15:04:05 MST",
-		"2006-01-02 15:04:05 (MST+3)",
-		time.UnixDate,
-		time.RubyDate,
-		time.RFC822,
-		time.RFC822Z,
-		time.RFC850,
-		time.RFC1123,
-		time.RFC1123Z,
-		time.RFC3339,
-		time.RFC3339Nano,
-
-		// Date only formats
-		"2006-01-02",
-		"02-Jan-2006",
-		"02.01.2006",
-		"02-01-2006",
-		"January _2 2006",
-		"Mon Jan _2 2006",
-		"02/01/2006",
-		"01/02/2006",
-		"2006/01/02",
-		"2006-Jan-02",
-		"before Jan-2006",
+// lastIPAddr returns the last address of the prefix
+func lastIPAddr(prefix netip.Prefix) netip.Addr {
+	end := prefix.Masked().Addr()
+	for i := prefix.Bits(); i < end.BitLen(); i++ {
+		end = setAddrBit(end, i)
 	}
 
-	for _, format := range formats {
-		result, err := time.Parse(format, datetime)
-		if err != nil {
-			continue
+	return end
+}
+
+// ipRangePrefixes returns the minimal list of CIDR prefixes covering the range from start to end
+func ipRangePrefixes(start, end netip.Addr) []netip.Prefix {
+	prefixes := []netip.Prefix{}
+
+	for start.IsValid() && start.Compare(end) <= 0 {
+		prefix := netip.PrefixFrom(start, start.BitLen())
+		for bits := 0; bits < start.BitLen(); bits++ {
+			v := netip.PrefixFrom(start, bits)
+			if v.Masked().Addr() == start && lastIPAddr(v).Compare(end) <= 0 {
+				prefix = v
+				break
+			}
 		}
-		return result, nil
+
+		prefixes = append(prefixes, prefix)
+		last := lastIPAddr(prefix)
+		if last == end {
+			break
+		}
+		start = last.Next()
+	}
+
+	return prefixes
+}
+
+// setAddrBit returns the address with the bit at position i set, counting from the most significant bit
+func setAddrBit(addr netip.Addr, i int) netip.Addr {
+	if addr.Is4() {
+		b := addr.As4()
+		b[i/8] |= 0x80 >> (i % 8)
+		return netip.AddrFrom4(b)
 	}
 
-	return time.Now(), fmt.Errorf("could not parse %s as a date", datetime)
+	b := addr.As16()
+	b[i/8] |= 0x80 >> (i % 8)
+	return netip.AddrFrom16(b)
+}
+
+// Keys returns all keys of map by sort
+func keys(m map[string]string) []string {
+	r := []string{}
+
+	for k := range m {
+		r = append(r, k)
+	}
+
+	sort.Strings(r)
+
+	return r
 }
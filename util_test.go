@@ -25,43 +25,70 @@ import (
 	"github.com/likexian/gokit/assert"
 )
 
-// https://github.com/golang/go/wiki/TableDrivenTests
-func TestParseDateString(t *testing.T) {
-	t.Parallel() // marks TLog as capable of running in parallel with other tests
+func TestParseIPRange(t *testing.T) {
 	tests := []struct {
-		date string
+		value string
+		start string
+		end   string
 	}{
-		{"09-Mar-2023"},
-		{"31-Jul-2022"},
-		{"2022-12-12T11:01:02Z"},
-		{"2022-12-03"},
-		{"2022. 12. 01."},
-		{"2022-12-12 11:40:12"},
-		{"2022.12.12 11:40:12"},
-		{"28/06/2022 23:59:59"},
-		{"24.10.2022"},
-		{"2022-06-29 14:08:21+03"},
-		{"31.8.2025 00:00:00"},
-		{"01-10-2025"},
-		{"20-Apr-2023 03:28:40"},
-		{"2022-12-08 14:00:00 CLST"},
-		{"December  2 2022"},
-		{"Mon Jan  2 2006"},
-		{"02/28/2025"},
-		{"2001/03/22"},
-		{"April 10 2023"},
-		{"2025-Dec-11"},
-		{"2025-Dec-11."},
-		{"2024-06-05 00:00:00 (UTC+8)"},
-		{"20221101 00:10:24"},
+		{"99.10.64.0 - 99.75.191.255", "99.10.64.0", "99.75.191.255"},
+		{"192.0.2.0/24", "192.0.2.0", "192.0.2.255"},
+		{"192.0.2.77/25", "192.0.2.0", "192.0.2.127"},
+		{"2001:db8::/32", "2001:db8::", "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff"},
+		{"2001:db8:: - 2001:db8::ff", "2001:db8::", "2001:db8::ff"},
 	}
 
-	for _, tt := range tests {
-		tt := tt // NOTE: https://github.com/golang/go/wiki/CommonMistakes#using-goroutines-on-loop-iterator-variables
-		t.Run(tt.date, func(t *testing.T) {
-			t.Parallel() // marks each test case as capable of running in parallel with each other
-			_, err := parseDateString(tt.date)
-			assert.Nil(t, err)
-		})
+	for _, v := range tests {
+		start, end, ok := parseIPRange(v.value)
+		assert.True(t, ok, v.value)
+		assert.Equal(t, start.String(), v.start)
+		assert.Equal(t, end.String(), v.end)
+	}
+
+	for _, v := range []string{"", "192.0.2.0", "192.0.2.9 - 192.0.2.1", "192.0.2.0 - 2001:db8::", "not an ip/24"} {
+		_, _, ok := parseIPRange(v)
+		assert.False(t, ok, v)
+	}
+}
+
+func TestExpandIPv4Prefix(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"200.7.84/23", "200.7.84.0/23"},
+		{"177.152/16", "177.152.0.0/16"},
+		{"10/8", "10.0.0.0/8"},
+		{"192.0.2.0/24", "192.0.2.0/24"},
+		{"2001:db8::/32", "2001:db8::/32"},
+		{"192.0.2.0 - 192.0.2.255", "192.0.2.0 - 192.0.2.255"},
+	}
+
+	for _, v := range tests {
+		assert.Equal(t, expandIPv4Prefix(v.value), v.expected, v.value)
+	}
+}
+
+func TestIPRangePrefixes(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected []string
+	}{
+		{"192.0.2.0 - 192.0.2.255", []string{"192.0.2.0/24"}},
+		{"99.10.64.0 - 99.75.191.255", []string{"99.10.64.0/18", "99.10.128.0/17", "99.11.0.0/16", "99.12.0.0/14", "99.16.0.0/12", "99.32.0.0/11", "99.64.0.0/13", "99.72.0.0/15", "99.74.0.0/16", "99.75.0.0/17", "99.75.128.0/18"}},
+		{"192.0.2.1 - 192.0.2.1", []string{"192.0.2.1/32"}},
+		{"0.0.0.0 - 255.255.255.255", []string{"0.0.0.0/0"}},
+		{"2001:db8:: - 2001:db8::1:ffff", []string{"2001:db8::/111"}},
+		{"2001:db8::1 - 2001:db8::2", []string{"2001:db8::1/128", "2001:db8::2/128"}},
+	}
+
+	for _, v := range tests {
+		start, end, ok := parseIPRange(v.value)
+		assert.True(t, ok, v.value)
+		prefixes := []string{}
+		for _, prefix := range ipRangePrefixes(start, end) {
+			prefixes = append(prefixes, prefix.String())
+		}
+		assert.Equal(t, prefixes, v.expected, v.value)
 	}
 }